@@ -0,0 +1,6 @@
+data "tableau_datasources" "all" {
+}
+
+data "tableau_datasource_permissions" "datasource_permissions" {
+  id = data.tableau_datasources.all.datasources[0].id
+}
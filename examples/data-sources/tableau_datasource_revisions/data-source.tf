@@ -0,0 +1,3 @@
+data "tableau_datasource_revisions" "example" {
+    id = data.tableau_datasource.example.id
+}
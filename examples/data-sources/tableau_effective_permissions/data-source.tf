@@ -0,0 +1,13 @@
+data "tableau_user" "alice" {
+  email = "alice@example.com"
+}
+
+data "tableau_effective_permissions" "alice_workbook" {
+  user_id      = data.tableau_user.alice.id
+  content_type = "workbook"
+  content_id   = "xxxxx-xxxxx-xxxxx"
+}
+
+output "alice_can_download" {
+  value = contains(data.tableau_effective_permissions.alice_workbook.allowed_capabilities, "ExportXml")
+}
@@ -0,0 +1,7 @@
+data "tableau_project" "example" {
+    id = "abc"
+}
+
+data "tableau_project" "reports" {
+    path = "Finance/EMEA/Reports"
+}
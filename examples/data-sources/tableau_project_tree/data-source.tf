@@ -0,0 +1,6 @@
+data "tableau_project_tree" "example" {
+}
+
+locals {
+  project_ids_by_path = { for p in data.tableau_project_tree.example.projects : p.path => p.id }
+}
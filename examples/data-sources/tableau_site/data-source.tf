@@ -1,3 +1,7 @@
 data "tableau_site" "example" {
     id = "abc"
 }
+
+data "tableau_site" "by_content_url" {
+    content_url = "marketing"
+}
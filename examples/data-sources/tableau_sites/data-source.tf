@@ -0,0 +1,2 @@
+data "tableau_sites" "example" {
+}
@@ -0,0 +1,4 @@
+data "tableau_view" "example" {
+    workbook_id = "xxxxx-xxxxx-xxxxx"
+    name        = "Overview"
+}
@@ -0,0 +1,6 @@
+data "tableau_views" "all" {
+}
+
+data "tableau_view_permissions" "view_permissions" {
+  id = data.tableau_views.all.views[0].id
+}
@@ -0,0 +1,5 @@
+data "tableau_views" "example" {
+    workbook_name            = "Superstore"
+    project_name             = "Samples"
+    include_usage_statistics = true
+}
@@ -0,0 +1,6 @@
+data "tableau_virtual_connections" "all" {
+}
+
+data "tableau_virtual_connection_permissions" "virtual_connection_permissions" {
+  id = data.tableau_virtual_connections.all.virtual_connections[0].id
+}
@@ -0,0 +1,6 @@
+data "tableau_workbooks" "all" {
+}
+
+data "tableau_workbook_permissions" "workbook_permissions" {
+  id = data.tableau_workbooks.all.workbooks[0].id
+}
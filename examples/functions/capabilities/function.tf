@@ -0,0 +1,8 @@
+resource "tableau_workbook_permission" "analysts" {
+  for_each = provider::tableau::capabilities("workbook", "Explore")
+
+  workbook_id     = "xxxxx-xxxxx-xxxxx"
+  group_id        = "xxxxx-xxxxx-xxxxx"
+  capability_name = each.key
+  capability_mode = each.value
+}
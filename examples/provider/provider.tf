@@ -3,3 +3,23 @@ provider "tableau" {
   server_version = "3.13"
   site           = "my_site"
 }
+
+# Manage content of several sites with one provider, resources and data sources select a site by alias, content URL or ID
+provider "tableau" {
+  alias          = "multi_site"
+  server_url     = "https://my.tableau.server.com"
+  server_version = "3.13"
+  site           = ""
+
+  sites = [
+    { content_url = "finance", alias = "fin" },
+    { content_url = "marketing" },
+  ]
+}
+
+resource "tableau_project" "finance_reports" {
+  provider            = tableau.multi_site
+  site                = "fin"
+  name                = "Reports"
+  content_permissions = "ManagedByOwner"
+}
@@ -0,0 +1,6 @@
+resource "tableau_data_role_permission" "test_permission" {
+  data_role_id = "xxxxx-xxxxx-xxxxx"
+	user_id = "xxxxx-xxxxx-xxxxx"
+  capability_name = "Read"
+	capability_mode = "Allow"
+}
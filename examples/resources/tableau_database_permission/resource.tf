@@ -0,0 +1,6 @@
+resource "tableau_database_permission" "test_permission" {
+  database_id = "xxxxx-xxxxx-xxxxx"
+	user_id = "xxxxx-xxxxx-xxxxx"
+  capability_name = "Connect"
+	capability_mode = "Allow"
+}
@@ -0,0 +1,2 @@
+terraform import tableau_datasource_connection.example "<datasource_id>:<connection_id>"
+terraform import tableau_datasource_connection.example "<datasource_id>:<connection_id>:<site>"
@@ -0,0 +1,3 @@
+terraform import tableau_datasource_revision.example "<datasource_id>"
+terraform import tableau_datasource_revision.example "Finance/EMEA/Revenue"
+terraform import tableau_datasource_revision.example "Finance/EMEA/Revenue:<site>"
@@ -0,0 +1,4 @@
+resource "tableau_datasource_revision" "example" {
+  datasource_id   = "xxxxx-xxxxx-xxxxx"
+  revision_number = "3"
+}
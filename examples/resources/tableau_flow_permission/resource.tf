@@ -0,0 +1,6 @@
+resource "tableau_flow_permission" "test_permission" {
+  flow_id = "xxxxx-xxxxx-xxxxx"
+	user_id = "xxxxx-xxxxx-xxxxx"
+  capability_name = "Read"
+	capability_mode = "Allow"
+}
@@ -1 +1,3 @@
 terraform import tableau_group.example "group_id"
+terraform import tableau_group.example "Analysts"
+terraform import tableau_group.example "Analysts:<site>"
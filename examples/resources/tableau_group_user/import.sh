@@ -1 +1,3 @@
 terraform import group_user.example "group_id:user_id"
+terraform import tableau_group_user.example "Analysts:jane.doe@example.com"
+terraform import tableau_group_user.example "Analysts:jdoe:<site>"
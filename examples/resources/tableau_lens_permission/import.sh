@@ -0,0 +1,6 @@
+terraform import tableau_lens_permission.example "lenses/<lens_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>"
+terraform import tableau_lens_permission.example "lenses/<lens_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>:<site_id>"
+
+# Import by content, grantee name and capability, the mode defaults to Allow and the site to the provider site
+terraform import tableau_lens_permission.example "<lens_id>:<user name, user email or group name>:<capability_name>"
+terraform import tableau_lens_permission.example "<lens_id>:<user name, user email or group name>:<capability_name>:<capability_mode>:<site>"
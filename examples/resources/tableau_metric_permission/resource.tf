@@ -0,0 +1,6 @@
+resource "tableau_metric_permission" "test_permission" {
+  metric_id = "xxxxx-xxxxx-xxxxx"
+	user_id = "xxxxx-xxxxx-xxxxx"
+  capability_name = "Read"
+	capability_mode = "Allow"
+}
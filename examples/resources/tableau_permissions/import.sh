@@ -0,0 +1,2 @@
+terraform import tableau_permissions.example "<content_type>:<content_id>"
+terraform import tableau_permissions.example "<content_type>:<content_id>:<site>"
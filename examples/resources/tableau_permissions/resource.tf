@@ -0,0 +1,21 @@
+resource "tableau_permissions" "workbook" {
+  content_type = "workbook"
+  content_id   = "xxxxx-xxxxx-xxxxx"
+
+  grantee_capabilities = [
+    {
+      group_id = "xxxxx-xxxxx-xxxxx"
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Filter", mode = "Allow" },
+        { name = "ExportImage", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = "xxxxx-xxxxx-xxxxx"
+      capabilities = [
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+  ]
+}
@@ -1 +1,3 @@
 terraform import tableau_project.example "project_id"
+terraform import tableau_project.example "Finance/EMEA/Reports"
+terraform import tableau_project.example "Finance/EMEA/Reports:<site>"
@@ -0,0 +1,2 @@
+terraform import tableau_project_default_permissions.example "<project_id>:<target_type>"
+terraform import tableau_project_default_permissions.example "<project_id>:<target_type>:<site_id>"
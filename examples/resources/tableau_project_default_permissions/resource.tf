@@ -0,0 +1,20 @@
+resource "tableau_project_default_permissions" "workbooks" {
+  project_id  = "xxxxx-xxxxx-xxxxx"
+  target_type = "workbooks"
+
+  grantee_capabilities = [
+    {
+      group_id = "xxxxx-xxxxx-xxxxx"
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "ExportData", mode = "Allow" },
+      ]
+    },
+    {
+      user_id = "xxxxx-xxxxx-xxxxx"
+      capabilities = [
+        { name = "Write", mode = "Deny" },
+      ]
+    },
+  ]
+}
@@ -1 +1,7 @@
 terraform import tableau_project_permission.example "projects/<project_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>"
+terraform import tableau_project_permission.example "projects/<project_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>:<site_id>"
+
+# Import by content, grantee name and capability, the mode defaults to Allow and the site to the provider site
+terraform import tableau_project_permission.example "<project_id or project path>:<user name, user email or group name>:<capability_name>"
+terraform import tableau_project_permission.example "<project_id or project path>:<user name, user email or group name>:<capability_name>:<capability_mode>:<site>"
+terraform import tableau_project_permission.example "Finance/EMEA:Analysts:Read:Deny"
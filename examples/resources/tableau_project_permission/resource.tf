@@ -4,3 +4,11 @@ resource "tableau_project_permission" "test_permission" {
   capability_name = "Write"
 	capability_mode = "Deny"
 }
+
+resource "tableau_project_permission" "other_site_permission" {
+  site            = tableau_site.other.id
+  project_id      = "xxxxx-xxxxx-xxxxx"
+  group_id        = "xxxxx-xxxxx-xxxxx"
+  capability_name = "Read"
+  capability_mode = "Allow"
+}
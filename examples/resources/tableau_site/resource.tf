@@ -2,4 +2,24 @@ resource "tableau_site" "test" {
   name                = "test"
   content_url         = "Moo"
   recycle_bin_enabled = false # Default is false
+
+  admin_mode               = "ContentOnly"
+  user_quota               = 100
+  revision_history_enabled = true
+  revision_limit           = 25
+  commenting_enabled       = false
+  extract_encryption_mode  = "enforced"
+
+  deletion_protection = true
+  on_destroy          = "suspend"
+}
+
+# Site administered through a group instead of a licensed provider user
+resource "tableau_site" "governed" {
+  name        = "governed"
+  content_url = "governed"
+
+  bootstrap_admin_enabled   = false
+  bootstrap_admin_group     = "Site Admins"
+  bootstrap_admin_site_role = "SiteAdministratorExplorer"
 }
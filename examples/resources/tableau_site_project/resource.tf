@@ -22,3 +22,15 @@ resource "tableau_site_project" "nested" {
   content_permissions = "ManagedByOwner"
   owner_id            = tableau_site_user.owner.id
 }
+
+# Move remaining content to another project when the project is destroyed
+resource "tableau_site_project" "sandbox" {
+  name                = "Sandbox"
+  site                = tableau_site.example.id
+  content_permissions = "ManagedByOwner"
+  deletion_protection = false
+
+  content_on_destroy = {
+    move_to = tableau_site_project.example.id
+  }
+}
@@ -1 +1,3 @@
 terraform import tableau_user.example "user_id"
+terraform import tableau_user.example "jane.doe@example.com"
+terraform import tableau_user.example "jdoe:<site>"
@@ -0,0 +1,2 @@
+terraform import tableau_workbook_connection.example "<workbook_id>:<connection_id>"
+terraform import tableau_workbook_connection.example "<workbook_id>:<connection_id>:<site>"
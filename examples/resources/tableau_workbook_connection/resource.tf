@@ -0,0 +1,10 @@
+resource "tableau_workbook_connection" "example" {
+  workbook_id         = "xxxxx-xxxxx-xxxxx"
+  connection_id       = "xxxxx-xxxxx-xxxxx"
+  server_address      = "warehouse.example.com"
+  server_port         = "5432"
+  username            = "tableau_reader"
+  password_wo         = var.warehouse_password
+  password_wo_version = 1
+  embed_password      = true
+}
@@ -1 +1,7 @@
 terraform import tableau_workbook_permission.example "workbooks/<workbook_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>"
+terraform import tableau_workbook_permission.example "workbooks/<workbook_id>/permissions/<entity_type>/<entity_id>/<capability_name>/<capability_mode>:<site_id>"
+
+# Import by content, grantee name and capability, the mode defaults to Allow and the site to the provider site
+terraform import tableau_workbook_permission.example "<workbook_id or project path/workbook name>:<user name, user email or group name>:<capability_name>"
+terraform import tableau_workbook_permission.example "<workbook_id or project path/workbook name>:<user name, user email or group name>:<capability_name>:<capability_mode>:<site>"
+terraform import tableau_workbook_permission.example "Finance/EMEA/Revenue:Analysts:ExportData:Deny"
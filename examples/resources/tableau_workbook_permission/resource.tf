@@ -4,3 +4,10 @@ resource "tableau_workbook_permission" "test_permission" {
   capability_name = "Write"
 	capability_mode = "Deny"
 }
+
+resource "tableau_workbook_permission" "named_permission" {
+  workbook_id     = "xxxxx-xxxxx-xxxxx"
+  grantee_name    = "Marketing Analysts"
+  capability_name = "Read"
+  capability_mode = "Allow"
+}
@@ -0,0 +1,4 @@
+resource "tableau_workbook_revision" "example" {
+  workbook_id     = "xxxxx-xxxxx-xxxxx"
+  revision_number = "3"
+}
@@ -0,0 +1,3 @@
+terraform import tableau_workbook_settings.example "<workbook_id>"
+terraform import tableau_workbook_settings.example "Finance/EMEA/Revenue"
+terraform import tableau_workbook_settings.example "Finance/EMEA/Revenue:<site>"
@@ -0,0 +1,14 @@
+resource "tableau_workbook_settings" "example" {
+  workbook_id      = "xxxxx-xxxxx-xxxxx"
+  project_id       = "xxxxx-xxxxx-xxxxx"
+  owner_id         = "xxxxx-xxxxx-xxxxx"
+  description      = "Managed by platform engineering"
+  show_tabs        = true
+  encrypt_extracts = false
+
+  data_freshness_policy = {
+    option                = "FreshEvery"
+    fresh_every_frequency = "Hours"
+    fresh_every_value     = 6
+  }
+}
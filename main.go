@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/svdimchenko/terraform-provider-tableau/tableau"
@@ -12,6 +15,13 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-name tableau
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	err := providerserver.Serve(context.Background(), tableau.New, providerserver.ServeOpts{
 		Address: "registry.terraform.io/svdimchenko/tableau",
 	})
@@ -19,3 +29,51 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// export signs in with the provider environment variables and writes the configuration of the site, with its import blocks.
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	dir := flags.String("dir", ".", "Directory the .tf files are written to")
+	site := flags.String("site", os.Getenv("TABLEAU_SITE_NAME"), "Content URL of the site to export, defaults to TABLEAU_SITE_NAME")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-tableau export [-dir <dir>] [-site <content_url>]")
+		fmt.Fprintln(flags.Output(), "Exports the site settings, users, groups, group memberships, projects, project permissions and default permissions.")
+		fmt.Fprintln(flags.Output(), "Workbooks, data sources, views and the other content of the projects are not exported, nor are their permissions.")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	serverURL := os.Getenv("TABLEAU_SERVER_URL")
+	serverVersion := os.Getenv("TABLEAU_SERVER_VERSION")
+	username := os.Getenv("TABLEAU_USERNAME")
+	password := os.Getenv("TABLEAU_PASSWORD")
+	personalAccessTokenName := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_NAME")
+	personalAccessTokenSecret := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_SECRET")
+	if serverURL == "" || serverVersion == "" {
+		return fmt.Errorf("TABLEAU_SERVER_URL and TABLEAU_SERVER_VERSION must be set")
+	}
+	if (username == "" || password == "") && (personalAccessTokenName == "" || personalAccessTokenSecret == "") {
+		return fmt.Errorf("TABLEAU_USERNAME and TABLEAU_PASSWORD, or TABLEAU_PERSONAL_ACCESS_TOKEN_NAME and TABLEAU_PERSONAL_ACCESS_TOKEN_SECRET must be set")
+	}
+
+	client, err := tableau.NewClient(
+		&serverURL,
+		&username,
+		&password,
+		&personalAccessTokenName,
+		&personalAccessTokenSecret,
+		site,
+		&serverVersion,
+	)
+	if err != nil {
+		return fmt.Errorf("could not sign in to Tableau: %w", err)
+	}
+	if err := tableau.ExportSite(client, *dir); err != nil {
+		return err
+	}
+	fmt.Printf("Exported site %q to %s, review the configuration then run terraform plan to import it\n", *site, *dir)
+	fmt.Println("Only project permissions and default permissions were exported, the permissions of workbooks, data sources and views were not")
+	return nil
+}
@@ -0,0 +1,171 @@
+package tableau
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// CapabilityRegistryVersion is the Tableau release the capability registry and templates were last checked against.
+// Bump it whenever capabilities or templates change, it is reported by the capabilities provider function.
+const CapabilityRegistryVersion = "2024.2"
+
+// contentTypeCapabilities lists the capability names each content type of permissionContentTypes accepts.
+var contentTypeCapabilities = map[string][]string{
+	"database":           {"ChangePermissions", "Connect", "Read", "Write"},
+	"datarole":           {"ChangeHierarchy", "ChangePermissions", "Delete", "Read", "Write"},
+	"datasource":         {"ChangePermissions", "Connect", "Delete", "ExportXml", "Read", "SaveAs", "Write"},
+	"flow":               {"ChangeHierarchy", "ChangePermissions", "Delete", "Execute", "ExportXml", "Read", "WebAuthoringForFlows", "Write"},
+	"lens":               {"ChangeHierarchy", "ChangePermissions", "Delete", "Read", "Write"},
+	"metric":             {"ChangeHierarchy", "ChangePermissions", "Delete", "Read", "Write"},
+	"project":            {"ProjectLeader", "Read", "Write"},
+	"table":              {"ChangePermissions", "Connect", "Read", "Write"},
+	"view":               {"AddComment", "ChangePermissions", "Delete", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring", "Write"},
+	"virtual_connection": {"ChangeHierarchy", "ChangePermissions", "Connect", "Delete", "Overwrite", "Read"},
+	"workbook":           {"AddComment", "ChangeHierarchy", "ChangePermissions", "CreateRefreshMetrics", "Delete", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "RunExplainData", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring", "Write"},
+}
+
+// capabilityTemplates mirrors the permission templates of the Tableau UI, each template adds capabilities to the previous one.
+// The Administer template of every content type grants all of its capabilities.
+var capabilityTemplates = map[string]map[string][]string{
+	"database": {
+		"View":    {"Read"},
+		"Explore": {"Read", "Connect"},
+		"Publish": {"Read", "Connect", "Write"},
+	},
+	"datarole": {
+		"View":    {"Read"},
+		"Publish": {"Read", "Write"},
+	},
+	"datasource": {
+		"View":    {"Read"},
+		"Explore": {"Read", "Connect"},
+		"Publish": {"Read", "Connect", "ExportXml", "SaveAs", "Write"},
+	},
+	"flow": {
+		"View":    {"Read"},
+		"Explore": {"Read", "ExportXml"},
+		"Publish": {"Read", "ExportXml", "Execute", "WebAuthoringForFlows", "Write"},
+	},
+	"lens": {
+		"View":    {"Read"},
+		"Publish": {"Read", "Write"},
+	},
+	"metric": {
+		"View":    {"Read"},
+		"Publish": {"Read", "Write"},
+	},
+	"project": {
+		"View":    {"Read"},
+		"Publish": {"Read", "Write"},
+	},
+	"table": {
+		"View":    {"Read"},
+		"Explore": {"Read", "Connect"},
+		"Publish": {"Read", "Connect", "Write"},
+	},
+	"view": {
+		"View":    {"AddComment", "ExportData", "ExportImage", "Filter", "Read", "ViewComments"},
+		"Explore": {"AddComment", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring"},
+		"Publish": {"AddComment", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring", "Write"},
+	},
+	"virtual_connection": {
+		"View":    {"Read"},
+		"Explore": {"Read", "Connect"},
+		"Publish": {"Read", "Connect", "Overwrite"},
+	},
+	"workbook": {
+		"View":    {"AddComment", "ExportData", "ExportImage", "Filter", "Read", "ViewComments"},
+		"Explore": {"AddComment", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "RunExplainData", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring"},
+		"Publish": {"AddComment", "CreateRefreshMetrics", "ExportData", "ExportImage", "ExportXml", "Filter", "Read", "RunExplainData", "ShareView", "ViewComments", "ViewUnderlyingData", "WebAuthoring", "Write"},
+	},
+}
+
+// GetCapabilityTemplate returns the capabilities of a template, mapped to the Allow mode.
+func GetCapabilityTemplate(contentType, template string) (map[string]string, error) {
+	capabilities, ok := contentTypeCapabilities[contentType]
+	if !ok {
+		return nil, fmt.Errorf("unknown content type (%s) not in: %s", contentType, strings.Join(getPermissionContentTypes(), ", "))
+	}
+	templates := capabilityTemplates[contentType]
+	if template != "Administer" {
+		var ok bool
+		capabilities, ok = templates[template]
+		if !ok {
+			templateNames := []string{}
+			for name := range templates {
+				templateNames = append(templateNames, name)
+			}
+			templateNames = append(templateNames, "Administer")
+			sort.Strings(templateNames)
+			return nil, fmt.Errorf("unknown template (%s) for %s not in: %s", template, contentType, strings.Join(templateNames, ", "))
+		}
+	}
+	result := map[string]string{}
+	for _, capability := range capabilities {
+		result[capability] = "Allow"
+	}
+	return result, nil
+}
+
+// capabilityNameDescription describes the capability_name attribute of the permission resources of a content type.
+func capabilityNameDescription(contentType string) string {
+	return "The capability to assign permissions to, one of " + strings.Join(contentTypeCapabilities[contentType], "/")
+}
+
+// capabilityNameValidator validates the capability_name attribute of the permission resources of a content type.
+func capabilityNameValidator(contentType string) validator.String {
+	return stringvalidator.OneOf(contentTypeCapabilities[contentType]...)
+}
+
+// viewerCapabilities are the only capabilities the Viewer license can exercise.
+var viewerCapabilities = []string{
+	"AddComment",
+	"ExportData",
+	"ExportImage",
+	"Filter",
+	"Read",
+	"RunExplainData",
+	"ShareView",
+	"ViewComments",
+}
+
+// publishCapabilities need a site role that can publish.
+var publishCapabilities = []string{
+	"ChangeHierarchy",
+	"Overwrite",
+	"SaveAs",
+	"Write",
+}
+
+// creatorCapabilities need the Creator license.
+var creatorCapabilities = []string{
+	"WebAuthoringForFlows",
+}
+
+// administratorSiteRoles are the site roles granted every capability their license allows.
+var administratorSiteRoles = []string{
+	"ServerAdministrator",
+	"SiteAdministratorCreator",
+	"SiteAdministratorExplorer",
+}
+
+// SiteRoleAllowsCapability reports whether the license of a site role caps the capability, whatever the grants say.
+// Unknown site roles are not capped.
+func SiteRoleAllowsCapability(siteRole, capability string) bool {
+	switch siteRole {
+	case "Unlicensed":
+		return false
+	case "Viewer", "ReadOnly":
+		return slices.Contains(viewerCapabilities, capability)
+	case "Explorer":
+		return !slices.Contains(publishCapabilities, capability) && !slices.Contains(creatorCapabilities, capability)
+	case "ExplorerCanPublish", "SiteAdministratorExplorer":
+		return !slices.Contains(creatorCapabilities, capability)
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package tableau
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &capabilitiesFunction{}
+)
+
+func NewCapabilitiesFunction() function.Function {
+	return &capabilitiesFunction{}
+}
+
+type capabilitiesFunction struct{}
+
+func (f *capabilitiesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "capabilities"
+}
+
+func (f *capabilitiesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Capabilities of a permission template",
+		Description: "Returns the capabilities of a Tableau permission template (View, Explore, Publish or Administer) for a content type, as a map of capability name to Allow mode. Templates follow the capability registry of Tableau " + CapabilityRegistryVersion + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content_type",
+				Description: "Type of the content, one of " + strings.Join(getPermissionContentTypes(), "/"),
+			},
+			function.StringParameter{
+				Name:        "template",
+				Description: "Name of the template, one of View/Explore/Publish/Administer, not every content type has all templates",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *capabilitiesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var contentType, template string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &contentType, &template))
+	if resp.Error != nil {
+		return
+	}
+
+	capabilities, err := GetCapabilityTemplate(contentType, template)
+	if err != nil {
+		argument := int64(1)
+		if _, ok := contentTypeCapabilities[contentType]; !ok {
+			argument = 0
+		}
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, capabilities))
+}
@@ -0,0 +1,51 @@
+package tableau
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCapabilityTemplatesUseRegistry(t *testing.T) {
+	for contentType := range permissionContentTypes {
+		if _, ok := contentTypeCapabilities[contentType]; !ok {
+			t.Errorf("%s: missing from the capability registry", contentType)
+		}
+		for template, capabilities := range capabilityTemplates[contentType] {
+			for _, capability := range capabilities {
+				if !slices.Contains(contentTypeCapabilities[contentType], capability) {
+					t.Errorf("%s %s template: capability %s is not in the registry", contentType, template, capability)
+				}
+			}
+		}
+	}
+}
+
+func TestGetCapabilityTemplate(t *testing.T) {
+	capabilities, err := GetCapabilityTemplate("workbook", "Explore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capabilities["WebAuthoring"] != "Allow" {
+		t.Errorf("workbook Explore template: expected WebAuthoring to be allowed, got %v", capabilities)
+	}
+	if _, ok := capabilities["Write"]; ok {
+		t.Errorf("workbook Explore template: did not expect Write, got %v", capabilities)
+	}
+
+	capabilities, err = GetCapabilityTemplate("project", "Administer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(capabilities) != len(contentTypeCapabilities["project"]) {
+		t.Errorf("project Administer template: expected every capability, got %v", capabilities)
+	}
+
+	_, err = GetCapabilityTemplate("project", "Explore")
+	if err == nil {
+		t.Error("project Explore template: expected an error")
+	}
+	_, err = GetCapabilityTemplate("dashboard", "View")
+	if err == nil {
+		t.Error("dashboard content type: expected an error")
+	}
+}
@@ -2,10 +2,12 @@ package tableau
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -21,6 +23,16 @@ type Client struct {
 	PersonalAccessTokenName   string
 	PersonalAccessTokenSecret string
 	ServerVersion             string
+	// SiteRoleValidation is how permission resources react to capabilities the grantee site role cannot use: off, warn or enforce
+	SiteRoleValidation string
+	// SiteContentURL is the content URL of the site the client is signed in to
+	SiteContentURL string
+	// SiteAliases maps the aliases of the sites declared in the provider to their content URL
+	SiteAliases map[string]string
+
+	sites           []Site
+	siteClients     map[string]*Client
+	siteClientsLock sync.Mutex
 }
 
 type SiteDetails struct {
@@ -99,6 +111,7 @@ func NewClient(server, username, password, personalAccessTokenName, personalAcce
 		c.AuthToken = ar.SignInResponseData.Token
 		c.Username = *username
 		c.SiteID = *ar.SignInResponseData.SiteDetails.ID
+		c.SiteContentURL = *site
 		c.ServerURL = *server
 		c.ServerVersion = *serverVersion
 		if password != nil {
@@ -182,16 +195,35 @@ func (c *Client) NewSiteAuthenticatedClient(siteID string) (*Client, error) {
 	newClient.Password = c.Password
 	newClient.PersonalAccessTokenName = c.PersonalAccessTokenName
 	newClient.PersonalAccessTokenSecret = c.PersonalAccessTokenSecret
+	newClient.SiteRoleValidation = c.SiteRoleValidation
+	newClient.SiteContentURL = site.ContentURL
+	newClient.SiteAliases = c.SiteAliases
 
 	return &newClient, nil
 }
 
+// contentTransferTimeout bounds the downloads and publishes of content files, which take far longer than the other API calls.
+const contentTransferTimeout = 30 * time.Minute
+
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	return c.doRequestWithClient(c.HTTPClient, req)
+}
+
+// doContentRequest sends a request downloading or publishing a content file with the longer contentTransferTimeout.
+func (c *Client) doContentRequest(req *http.Request) ([]byte, error) {
+	httpClient := *c.HTTPClient
+	httpClient.Timeout = contentTransferTimeout
+	return c.doRequestWithClient(&httpClient, req)
+}
+
+func (c *Client) doRequestWithClient(httpClient *http.Client, req *http.Request) ([]byte, error) {
 	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Add("Content-Type", "application/json")
+	}
 	req.Header.Add("X-Tableau-Auth", c.AuthToken)
 
-	res, err := c.HTTPClient.Do(req)
+	res, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -203,12 +235,28 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	}
 
 	if (res.StatusCode != http.StatusOK) && (res.StatusCode != 201) && (res.StatusCode != 204) && (res.StatusCode != 202) {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &StatusError{StatusCode: res.StatusCode, Body: body}
 	}
 
 	return body, err
 }
 
+// StatusError is returned for a request the API answered with an error status.
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether the API answered a request with 404 Not Found.
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
 // NewSiteClient creates a new client authenticated to a specific site.
 func (c *Client) NewSiteClient(siteID string) (*Client, error) {
 	siteClient := &Client{
@@ -1,7 +1,12 @@
 package tableau
 
 import (
+	"bytes"
+	"fmt"
 	"math"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"strconv"
 	"strings"
 )
@@ -44,3 +49,53 @@ func GetIDsFromCombinedID(id string) (string, string) {
 	split := strings.Split(id, ":")
 	return split[0], split[1]
 }
+
+// NewPublishRequest builds a multipart/mixed publish or file upload request with a JSON payload and a content file.
+// The file part is left out when content is nil, for content already sent through a file upload session.
+func NewPublishRequest(method, url, fileFieldName, fileName string, payload, content []byte) (*http.Request, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	payloadHeader := textproto.MIMEHeader{}
+	payloadHeader.Set("Content-Disposition", `name="request_payload"`)
+	payloadHeader.Set("Content-Type", "application/json")
+	payloadPart, err := writer.CreatePart(payloadHeader)
+	if err != nil {
+		return nil, err
+	}
+	_, err = payloadPart.Write(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if content != nil {
+		fileHeader := textproto.MIMEHeader{}
+		fileHeader.Set("Content-Disposition", fmt.Sprintf(`name="%s"; filename="%s"`, fileFieldName, fileName))
+		fileHeader.Set("Content-Type", "application/octet-stream")
+		filePart, err := writer.CreatePart(fileHeader)
+		if err != nil {
+			return nil, err
+		}
+		_, err = filePart.Write(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+	return req, nil
+}
+
+// IsPackagedContent reports whether downloaded content is a zip package (.tdsx/.twbx) rather than plain XML.
+func IsPackagedContent(content []byte) bool {
+	return bytes.HasPrefix(content, []byte("PK"))
+}
@@ -0,0 +1,183 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Connection is a connection of a published workbook or data source, as managed by the connection resources.
+type Connection struct {
+	ID                  string `json:"id,omitempty"`
+	Type                string `json:"type,omitempty"`
+	ServerAddress       string `json:"serverAddress,omitempty"`
+	ServerPort          string `json:"serverPort,omitempty"`
+	UserName            string `json:"userName,omitempty"`
+	QueryTaggingEnabled bool   `json:"queryTaggingEnabled,omitempty"`
+	EmbedPassword       bool   `json:"embedPassword,omitempty"`
+}
+
+type ConnectionResponse struct {
+	Connection Connection `json:"connection"`
+}
+
+type ConnectionsResponse struct {
+	Connections []Connection `json:"connection"`
+}
+
+type ConnectionListResponse struct {
+	ConnectionsResponse ConnectionsResponse `json:"connections"`
+}
+
+// ConnectionUpdate holds the connection attributes that can be changed on a published workbook or data source.
+type ConnectionUpdate struct {
+	ServerAddress       string `json:"serverAddress,omitempty"`
+	ServerPort          string `json:"serverPort,omitempty"`
+	UserName            string `json:"userName,omitempty"`
+	Password            string `json:"password,omitempty"`
+	EmbedPassword       *bool  `json:"embedPassword,omitempty"`
+	QueryTaggingEnabled *bool  `json:"queryTaggingEnabled,omitempty"`
+}
+
+type ConnectionUpdateRequest struct {
+	Connection ConnectionUpdate `json:"connection"`
+}
+
+// GetConnection returns a connection of the content at contentPath, workbooks or datasources, with the given ID.
+func (c *Client) GetConnection(contentPath, contentID, connectionID string) (*Connection, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/%s/connections", c.ApiUrl, contentPath, contentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	connectionListResponse := ConnectionListResponse{}
+	err = json.Unmarshal(body, &connectionListResponse)
+	if err != nil {
+		return nil, err
+	}
+	// connections are not paginated
+	for _, connection := range connectionListResponse.ConnectionsResponse.Connections {
+		if connection.ID == connectionID {
+			return &connection, nil
+		}
+	}
+	return nil, fmt.Errorf("did not find connection ID %s in %s ID %s", connectionID, strings.TrimSuffix(contentPath, "s"), contentID)
+}
+
+// UpdateConnection updates a connection of the content at contentPath, workbooks or datasources.
+func (c *Client) UpdateConnection(contentPath, contentID, connectionID string, connection ConnectionUpdate) (*Connection, error) {
+	connectionRequest := ConnectionUpdateRequest{
+		Connection: connection,
+	}
+
+	newConnectionJson, err := json.Marshal(connectionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s/%s/connections/%s", c.ApiUrl, contentPath, contentID, connectionID), strings.NewReader(string(newConnectionJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionResponse := ConnectionResponse{}
+	err = json.Unmarshal(body, &connectionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionResponse.Connection, nil
+}
+
+// getConnectionUpdate builds the update body from the configured values, leaving unset values untouched on the server.
+func getConnectionUpdate(serverAddress, serverPort, userName, password types.String, embedPassword, queryTaggingEnabled types.Bool) ConnectionUpdate {
+	connection := ConnectionUpdate{
+		ServerAddress: serverAddress.ValueString(),
+		ServerPort:    serverPort.ValueString(),
+		UserName:      userName.ValueString(),
+		Password:      password.ValueString(),
+	}
+	if !embedPassword.IsNull() && !embedPassword.IsUnknown() {
+		v := embedPassword.ValueBool()
+		connection.EmbedPassword = &v
+	}
+	if !queryTaggingEnabled.IsNull() && !queryTaggingEnabled.IsUnknown() {
+		v := queryTaggingEnabled.ValueBool()
+		connection.QueryTaggingEnabled = &v
+	}
+	return connection
+}
+
+// importConnectionState imports a connection resource from "contentID:connectionID", optionally followed by the site of the content.
+func importConnectionState(ctx context.Context, client *Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, contentAttribute string) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format '<"+contentAttribute+">:<connection_id>' or '<"+contentAttribute+">:<connection_id>:<site>', got '"+req.ID+"'",
+		)
+		return
+	}
+	if len(parts) == 3 {
+		if _, err := client.ResolveSiteID(parts[2]); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Could not resolve the site of import ID '"+req.ID+"': "+err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), parts[2])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), GetCombinedID(parts[0], parts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(contentAttribute), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), parts[1])...)
+}
+
+// updateConnection updates a connection of the content at contentPath on the given site and reads it back.
+func updateConnection(client *Client, site types.String, contentPath, contentID, connectionID string, update ConnectionUpdate) (*Connection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	contentType := strings.TrimSuffix(contentPath, "s")
+	siteClient, err := client.ResolveSiteClient(site.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	_, err = siteClient.UpdateConnection(contentPath, contentID, connectionID, update)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Tableau Connection",
+			"Could not update "+contentType+" connection, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	connection, err := siteClient.GetConnection(contentPath, contentID, connectionID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau Connection",
+			"Could not read Tableau "+contentType+" connection ID "+connectionID+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	return connection, diags
+}
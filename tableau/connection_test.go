@@ -0,0 +1,37 @@
+package tableau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.19/sites/site-id/workbooks/workbook-id/connections" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"connections":{"connection":[
+			{"id":"other-id","serverAddress":"other.example.com"},
+			{"id":"connection-id","serverAddress":"warehouse.example.com","serverPort":"5432","queryTaggingEnabled":true,"embedPassword":true}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		ApiUrl:     server.URL + "/api/3.19/sites/site-id",
+	}
+	connection, err := client.GetConnection("workbooks", "workbook-id", "connection-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if connection.ServerAddress != "warehouse.example.com" || connection.ServerPort != "5432" || !connection.QueryTaggingEnabled || !connection.EmbedPassword {
+		t.Errorf("unexpected connection %+v", connection)
+	}
+
+	if _, err := client.GetConnection("workbooks", "workbook-id", "unknown-id"); err == nil {
+		t.Error("expected an error for an unknown connection")
+	}
+}
@@ -0,0 +1,187 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &contentPermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentPermissionsDataSource{}
+)
+
+func WorkbookPermissionsDataSource() datasource.DataSource {
+	return &contentPermissionsDataSource{contentType: "workbook", contentName: "workbook"}
+}
+
+func DatasourcePermissionsDataSource() datasource.DataSource {
+	return &contentPermissionsDataSource{contentType: "datasource", contentName: "datasource"}
+}
+
+func ViewPermissionsDataSource() datasource.DataSource {
+	return &contentPermissionsDataSource{contentType: "view", contentName: "view"}
+}
+
+func VirtualConnectionPermissionsDataSource() datasource.DataSource {
+	return &contentPermissionsDataSource{contentType: "virtual_connection", contentName: "virtual connection"}
+}
+
+// contentPermissionsDataSource reads the full permissions document of one content type, see permissionContentTypes.
+type contentPermissionsDataSource struct {
+	client      *Client
+	contentType string
+	contentName string
+}
+
+type NamedGranteeCapabilityModel struct {
+	UserID       types.String      `tfsdk:"user_id"`
+	UserName     types.String      `tfsdk:"user_name"`
+	GroupID      types.String      `tfsdk:"group_id"`
+	GroupName    types.String      `tfsdk:"group_name"`
+	Capabilities []CapabilityModel `tfsdk:"capabilities"`
+}
+
+type contentPermissionsDataSourceModel struct {
+	Site                types.String                  `tfsdk:"site"`
+	ID                  types.String                  `tfsdk:"id"`
+	GranteeCapabilities []NamedGranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+}
+
+func (d *contentPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.contentType + "_permissions"
+}
+
+func (d *contentPermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve " + d.contentName + " permissions",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the " + d.contentName,
+			},
+			"grantee_capabilities": schema.ListNestedAttribute{
+				Description: "List of grantee capabilities for users and groups",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the group",
+						},
+						"group_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the group",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user",
+						},
+						"user_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the user",
+						},
+						"capabilities": schema.ListNestedAttribute{
+							Description: "List of grantee capabilities for users and groups",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the capability",
+									},
+									"mode": schema.StringAttribute{
+										Computed:    true,
+										Description: "Mode of the capability (Allow/Deny)",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *contentPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state contentPermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	perms, err := siteClient.GetPermissions(d.contentType, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	userNames := map[string]string{}
+	groupNames := map[string]string{}
+	state.GranteeCapabilities = []NamedGranteeCapabilityModel{}
+	for _, granteeCapability := range getGranteeCapabilityModels(perms.GranteeCapabilities) {
+		newGranteeCapability := NamedGranteeCapabilityModel{
+			UserID:       granteeCapability.UserID,
+			UserName:     types.StringNull(),
+			GroupID:      granteeCapability.GroupID,
+			GroupName:    types.StringNull(),
+			Capabilities: granteeCapability.Capabilities,
+		}
+		if userID := granteeCapability.UserID.ValueString(); userID != "" {
+			if _, ok := userNames[userID]; !ok {
+				user, err := siteClient.GetUser(userID)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Read Tableau User",
+						err.Error(),
+					)
+					return
+				}
+				userNames[userID] = user.Name
+			}
+			newGranteeCapability.UserName = types.StringValue(userNames[userID])
+		}
+		if groupID := granteeCapability.GroupID.ValueString(); groupID != "" {
+			if _, ok := groupNames[groupID]; !ok {
+				group, err := siteClient.GetGroup(groupID)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Read Tableau Group",
+						err.Error(),
+					)
+					return
+				}
+				groupNames[groupID] = group.Name
+			}
+			newGranteeCapability.GroupName = types.StringValue(groupNames[groupID])
+		}
+		state.GranteeCapabilities = append(state.GranteeCapabilities, newGranteeCapability)
+	}
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *contentPermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
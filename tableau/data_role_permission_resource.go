@@ -0,0 +1,277 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &dataRolePermissionResource{}
+	_ resource.ResourceWithConfigure        = &dataRolePermissionResource{}
+	_ resource.ResourceWithImportState      = &dataRolePermissionResource{}
+	_ resource.ResourceWithConfigValidators = &dataRolePermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &dataRolePermissionResource{}
+)
+
+func NewDataRolePermissionResource() resource.Resource {
+	return &dataRolePermissionResource{}
+}
+
+type dataRolePermissionResource struct {
+	client *Client
+}
+
+type dataRolePermissionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DataRoleID     types.String `tfsdk:"data_role_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
+	CapabilityName types.String `tfsdk:"capability_name"`
+	CapabilityMode types.String `tfsdk:"capability_mode"`
+}
+
+func (r *dataRolePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_role_permission"
+}
+
+func (r *dataRolePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tableau Data Role Permission",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_role_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Data Role ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
+			"capability_name": schema.StringAttribute{
+				Required:    true,
+				Description: capabilityNameDescription("datarole"),
+				Validators: []validator.String{
+					capabilityNameValidator("datarole"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"capability_mode": schema.StringAttribute{
+				Required:    true,
+				Description: "Capability mode, Allow or Deny (case sensitive)",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Allow",
+						"Deny",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dataRolePermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *dataRolePermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
+func (r *dataRolePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataRolePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataRoleID := plan.DataRoleID.ValueString()
+	granted := GrantedCapability{
+		EntityType:     "users",
+		EntityID:       plan.UserID.ValueString(),
+		CapabilityName: plan.CapabilityName.ValueString(),
+		CapabilityMode: plan.CapabilityMode.ValueString(),
+	}
+	if granted.EntityID == "" {
+		granted.EntityType = "groups"
+		granted.EntityID = plan.GroupID.ValueString()
+	}
+	permissions := Permissions{
+		GranteeCapabilities: GetGranteeCapabilities([]GrantedCapability{granted}),
+	}
+
+	_, err = siteClient.CreatePermissions("datarole", dataRoleID, permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating data role permission",
+			"Could not create data role permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(getDataRolePermissionID(dataRoleID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), getClientSiteID(r.client, siteClient)))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dataRolePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataRolePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	dataRoleID, permission := getGrantedCapabilityFromID(permissionID)
+	granted, err := siteClient.GetPermission("datarole", dataRoleID, permission)
+	if err != nil || granted == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if granted.EntityType == "users" {
+		state.UserID = types.StringValue(granted.EntityID)
+	} else {
+		state.GroupID = types.StringValue(granted.EntityID)
+	}
+	state.ID = types.StringValue(getSiteScopedID(getDataRolePermissionID(dataRoleID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.DataRoleID = types.StringValue(dataRoleID)
+	state.CapabilityName = types.StringValue(granted.CapabilityName)
+	state.CapabilityMode = types.StringValue(granted.CapabilityMode)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dataRolePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataRolePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dataRolePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataRolePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	dataRoleID, permission := getGrantedCapabilityFromID(permissionID)
+	err = siteClient.DeletePermission("datarole", dataRoleID, permission.EntityType, permission.EntityID, permission.CapabilityName, permission.CapabilityMode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Data Role Permission",
+			"Could not delete permission on data role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dataRolePermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *dataRolePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPermissionState(ctx, r.client, req, resp, "datarole", nil, getDataRolePermissionID)
+}
+
+func getDataRolePermissionID(dataRoleID, entityType, entityID, capabilityName, capabilityMode string) string {
+	return fmt.Sprintf("dataroles/%s/permissions/%s/%s/%s/%s", dataRoleID, entityType, entityID, capabilityName, capabilityMode)
+}
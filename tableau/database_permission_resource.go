@@ -0,0 +1,277 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &databasePermissionResource{}
+	_ resource.ResourceWithConfigure        = &databasePermissionResource{}
+	_ resource.ResourceWithImportState      = &databasePermissionResource{}
+	_ resource.ResourceWithConfigValidators = &databasePermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &databasePermissionResource{}
+)
+
+func NewDatabasePermissionResource() resource.Resource {
+	return &databasePermissionResource{}
+}
+
+type databasePermissionResource struct {
+	client *Client
+}
+
+type databasePermissionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DatabaseID     types.String `tfsdk:"database_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
+	CapabilityName types.String `tfsdk:"capability_name"`
+	CapabilityMode types.String `tfsdk:"capability_mode"`
+}
+
+func (r *databasePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_permission"
+}
+
+func (r *databasePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tableau Database Permission",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Database ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
+			"capability_name": schema.StringAttribute{
+				Required:    true,
+				Description: capabilityNameDescription("database"),
+				Validators: []validator.String{
+					capabilityNameValidator("database"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"capability_mode": schema.StringAttribute{
+				Required:    true,
+				Description: "Capability mode, Allow or Deny (case sensitive)",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Allow",
+						"Deny",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *databasePermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *databasePermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
+func (r *databasePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databasePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseID := plan.DatabaseID.ValueString()
+	granted := GrantedCapability{
+		EntityType:     "users",
+		EntityID:       plan.UserID.ValueString(),
+		CapabilityName: plan.CapabilityName.ValueString(),
+		CapabilityMode: plan.CapabilityMode.ValueString(),
+	}
+	if granted.EntityID == "" {
+		granted.EntityType = "groups"
+		granted.EntityID = plan.GroupID.ValueString()
+	}
+	permissions := Permissions{
+		GranteeCapabilities: GetGranteeCapabilities([]GrantedCapability{granted}),
+	}
+
+	_, err = siteClient.CreatePermissions("database", databaseID, permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database permission",
+			"Could not create database permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(getDatabasePermissionID(databaseID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), getClientSiteID(r.client, siteClient)))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databasePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	databaseID, permission := getGrantedCapabilityFromID(permissionID)
+	granted, err := siteClient.GetPermission("database", databaseID, permission)
+	if err != nil || granted == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if granted.EntityType == "users" {
+		state.UserID = types.StringValue(granted.EntityID)
+	} else {
+		state.GroupID = types.StringValue(granted.EntityID)
+	}
+	state.ID = types.StringValue(getSiteScopedID(getDatabasePermissionID(databaseID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.DatabaseID = types.StringValue(databaseID)
+	state.CapabilityName = types.StringValue(granted.CapabilityName)
+	state.CapabilityMode = types.StringValue(granted.CapabilityMode)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databasePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databasePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databasePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	databaseID, permission := getGrantedCapabilityFromID(permissionID)
+	err = siteClient.DeletePermission("database", databaseID, permission.EntityType, permission.EntityID, permission.CapabilityName, permission.CapabilityMode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Database Permission",
+			"Could not delete permission on database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *databasePermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *databasePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPermissionState(ctx, r.client, req, resp, "database", nil, getDatabasePermissionID)
+}
+
+func getDatabasePermissionID(databaseID, entityType, entityID, capabilityName, capabilityMode string) string {
+	return fmt.Sprintf("databases/%s/permissions/%s/%s/%s/%s", databaseID, entityType, entityID, capabilityName, capabilityMode)
+}
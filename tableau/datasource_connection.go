@@ -0,0 +1,51 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type DatasourceConnection struct {
+	DatasourceID            string
+	ID                      string `json:"id,omitempty"`
+	Type                    string `json:"type,omitempty"`
+	ServerAddress           string `json:"serverAddress,omitempty"`
+	ServerPort              string `json:"serverPort,omitempty"`
+	UserName                string `json:"userName,omitempty"`
+	QueryTaggingEnabled     bool   `json:"queryTaggingEnabled,omitempty"`
+	AuthenticationType      string `json:"authenticationType,omitempty"`
+	EmbedPassword           bool   `json:"embedPassword,omitempty"`
+	UseOAuthManagedKeychain bool   `json:"useOauthManagedKeychain,omitempty"`
+}
+
+type DatasourceConnectionsResponse struct {
+	DatasourceConnections []DatasourceConnection `json:"connection"`
+}
+
+type DatasourceConnectionListResponse struct {
+	DatasourceConnectionsResponse DatasourceConnectionsResponse `json:"connections"`
+}
+
+func (c *Client) GetDatasourceConnections(datasourceID string) ([]DatasourceConnection, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources/%s/connections", c.ApiUrl, datasourceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	datasourceConnectionsListResponse := DatasourceConnectionListResponse{}
+	err = json.Unmarshal(body, &datasourceConnectionsListResponse)
+	if err != nil {
+		return nil, err
+	}
+	// like workbook connections, datasource connections are not paginated
+	allDatasourceConnections := datasourceConnectionsListResponse.DatasourceConnectionsResponse.DatasourceConnections
+	for idx := range allDatasourceConnections {
+		allDatasourceConnections[idx].DatasourceID = datasourceID
+	}
+	return allDatasourceConnections, nil
+}
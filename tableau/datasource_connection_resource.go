@@ -0,0 +1,257 @@
+package tableau
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &datasourceConnectionResource{}
+	_ resource.ResourceWithConfigure   = &datasourceConnectionResource{}
+	_ resource.ResourceWithImportState = &datasourceConnectionResource{}
+	_ resource.ResourceWithModifyPlan  = &datasourceConnectionResource{}
+)
+
+func NewDatasourceConnectionResource() resource.Resource {
+	return &datasourceConnectionResource{}
+}
+
+type datasourceConnectionResource struct {
+	client *Client
+}
+
+type datasourceConnectionResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Site                types.String `tfsdk:"site"`
+	DatasourceID        types.String `tfsdk:"datasource_id"`
+	ConnectionID        types.String `tfsdk:"connection_id"`
+	Type                types.String `tfsdk:"type"`
+	ServerAddress       types.String `tfsdk:"server_address"`
+	ServerPort          types.String `tfsdk:"server_port"`
+	UserName            types.String `tfsdk:"username"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	EmbedPassword       types.Bool   `tfsdk:"embed_password"`
+	QueryTaggingEnabled types.Bool   `tfsdk:"query_tagging_enabled"`
+	LastUpdated         types.String `tfsdk:"last_updated"`
+}
+
+func (r *datasourceConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource_connection"
+}
+
+func (r *datasourceConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an existing connection of a published datasource. Destroying the resource leaves the connection untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": siteAttribute(),
+			"datasource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the datasource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the datasource connection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Database connection type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_address": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Server address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_port": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Server port",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Username",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Database password or OAuth secret to embed, never stored in state - requires Terraform 1.11 or later",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of password_wo, change it to send a new password",
+			},
+			"embed_password": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Embed database password into connection",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_tagging_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Query tagging enabled",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the connection",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *datasourceConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
+func (r *datasourceConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config datasourceConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasourceID := plan.DatasourceID.ValueString()
+	connectionID := plan.ConnectionID.ValueString()
+	connection, diags := updateConnection(r.client, plan.Site, "datasources", datasourceID, connectionID, getConnectionUpdate(plan.ServerAddress, plan.ServerPort, plan.UserName, config.PasswordWO, plan.EmbedPassword, plan.QueryTaggingEnabled))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(GetCombinedID(datasourceID, connectionID))
+	setDatasourceConnectionState(&plan, connection)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state datasourceConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	datasourceID, connectionID := GetIDsFromCombinedID(state.ID.ValueString())
+	connection, err := siteClient.GetConnection("datasources", datasourceID, connectionID)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DatasourceID = types.StringValue(datasourceID)
+	state.ConnectionID = types.StringValue(connectionID)
+	setDatasourceConnectionState(&state, connection)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config datasourceConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasourceID, connectionID := GetIDsFromCombinedID(plan.ID.ValueString())
+	connection, diags := updateConnection(r.client, plan.Site, "datasources", datasourceID, connectionID, getConnectionUpdate(plan.ServerAddress, plan.ServerPort, plan.UserName, config.PasswordWO, plan.EmbedPassword, plan.QueryTaggingEnabled))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setDatasourceConnectionState(&plan, connection)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceConnectionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Connections only exist as part of their datasource, only stop managing it.
+}
+
+func (r *datasourceConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *datasourceConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importConnectionState(ctx, r.client, req, resp, "datasource_id")
+}
+
+func setDatasourceConnectionState(state *datasourceConnectionResourceModel, connection *Connection) {
+	state.Type = types.StringValue(connection.Type)
+	state.ServerAddress = types.StringValue(connection.ServerAddress)
+	state.ServerPort = types.StringValue(connection.ServerPort)
+	state.UserName = types.StringValue(connection.UserName)
+	state.EmbedPassword = types.BoolValue(connection.EmbedPassword)
+	state.QueryTaggingEnabled = types.BoolValue(connection.QueryTaggingEnabled)
+}
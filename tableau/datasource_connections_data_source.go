@@ -0,0 +1,153 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &datasourceConnectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &datasourceConnectionsDataSource{}
+)
+
+func DatasourceConnectionsDataSource() datasource.DataSource {
+	return &datasourceConnectionsDataSource{}
+}
+
+type datasourceConnectionsDataSource struct {
+	client *Client
+}
+
+type datasourceConnectionNestedDataModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Type                    types.String `tfsdk:"type"`
+	ServerAddress           types.String `tfsdk:"server_address"`
+	ServerPort              types.String `tfsdk:"server_port"`
+	UserName                types.String `tfsdk:"username"`
+	EmbedPassword           types.Bool   `tfsdk:"embed_password"`
+	QueryTaggingEnabled     types.Bool   `tfsdk:"query_tagging_enabled"`
+	AuthenticationType      types.String `tfsdk:"authentication_type"`
+	UseOAuthManagedKeychain types.Bool   `tfsdk:"use_oauth_managed_keychain"`
+}
+
+type datasourceConnectionsDataSourceModel struct {
+	Site        types.String                          `tfsdk:"site"`
+	ID          types.String                          `tfsdk:"id"`
+	Connections []datasourceConnectionNestedDataModel `tfsdk:"connections"`
+}
+
+func (d *datasourceConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource_connections"
+}
+
+func (d *datasourceConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve datasource connections details",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the datasource",
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "List datasource connections and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the datasource connection",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Database connection type",
+						},
+						"server_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server address",
+						},
+						"server_port": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server port",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "Username",
+						},
+						"embed_password": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Embed database password into connection",
+						},
+						"query_tagging_enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Query tagging enabled",
+						},
+						"authentication_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Authentication type",
+						},
+						"use_oauth_managed_keychain": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Use OAuth managed keychain",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datasourceConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasourceConnectionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	connections, err := siteClient.GetDatasourceConnections(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Datasource Connections",
+			err.Error(),
+		)
+		return
+	}
+	for _, connection := range connections {
+		datasourceConnection := datasourceConnectionNestedDataModel{
+			ID:                      types.StringValue(connection.ID),
+			Type:                    types.StringValue(connection.Type),
+			ServerAddress:           types.StringValue(connection.ServerAddress),
+			ServerPort:              types.StringValue(connection.ServerPort),
+			UserName:                types.StringValue(connection.UserName),
+			EmbedPassword:           types.BoolValue(connection.EmbedPassword),
+			QueryTaggingEnabled:     types.BoolValue(connection.QueryTaggingEnabled),
+			AuthenticationType:      types.StringValue(connection.AuthenticationType),
+			UseOAuthManagedKeychain: types.BoolValue(connection.UseOAuthManagedKeychain),
+		}
+		state.Connections = append(state.Connections, datasourceConnection)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *datasourceConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
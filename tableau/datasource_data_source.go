@@ -23,6 +23,7 @@ type datasourceDataSource struct {
 }
 
 type datasourceDataSourceModel struct {
+	Site                types.String `tfsdk:"site"`
 	ID                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
 	Description         types.String `tfsdk:"description"`
@@ -47,6 +48,7 @@ func (d *datasourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Retrieve datasource details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -115,7 +117,16 @@ func (d *datasourceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	datasource, err := d.client.GetDatasource(state.ID.ValueString(), state.Name.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	datasource, err := siteClient.GetDatasource(state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Datasource",
@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &datasourcePermissionResource{}
-	_ resource.ResourceWithConfigure   = &datasourcePermissionResource{}
-	_ resource.ResourceWithImportState = &datasourcePermissionResource{}
+	_ resource.Resource                     = &datasourcePermissionResource{}
+	_ resource.ResourceWithConfigure        = &datasourcePermissionResource{}
+	_ resource.ResourceWithImportState      = &datasourcePermissionResource{}
+	_ resource.ResourceWithConfigValidators = &datasourcePermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &datasourcePermissionResource{}
 )
 
 func NewDatasourcePermissionResource() resource.Resource {
@@ -34,6 +36,8 @@ type datasourcePermissionResourceModel struct {
 	DatasourceID   types.String `tfsdk:"datasource_id"`
 	UserID         types.String `tfsdk:"user_id"`
 	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
 }
@@ -61,31 +65,27 @@ func (r *datasourcePermissionResource) Schema(_ context.Context, _ resource.Sche
 			},
 			"user_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "User ID to grant to",
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Group ID to grant to",
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
 			"capability_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The capability to assign permissions to, one of ChangePermissions/Connect/Delete/ExportXml/Read/Write/SaveAs",
+				Description: capabilityNameDescription("datasource"),
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"ChangePermissions",
-						"Connect",
-						"Delete",
-						"ExportXml",
-						"Read",
-						"Write",
-						"SaveAs",
-					}...),
+					capabilityNameValidator("datasource"),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -108,6 +108,18 @@ func (r *datasourcePermissionResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *datasourcePermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *datasourcePermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validateContentNotLocked(ctx, client, "datasource", path.Root("datasource_id"), req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
 func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan datasourcePermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -116,6 +128,19 @@ func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	datasourceID := plan.DatasourceID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -141,7 +166,7 @@ func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateDatasourcePermissions(datasourceID, datasourcePermissions)
+	_, err = siteClient.CreateDatasourcePermissions(datasourceID, datasourcePermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating datasource permission",
@@ -150,7 +175,7 @@ func (r *datasourcePermissionResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	plan.ID = types.StringValue(getDatasourcePermissionID(datasourceID, entityType, entityID, capability.Name, capability.Mode))
+	plan.ID = types.StringValue(getSiteScopedID(getDatasourcePermissionID(datasourceID, entityType, entityID, capability.Name, capability.Mode), getClientSiteID(r.client, siteClient)))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -167,8 +192,18 @@ func (r *datasourcePermissionResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	permission := getDatasourcePermissionFromID(state.ID.ValueString())
-	datasourcePermission, err := r.client.GetDatasourcePermission(permission.DatasourceID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getDatasourcePermissionFromID(permissionID)
+	datasourcePermission, err := siteClient.GetDatasourcePermission(permission.DatasourceID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -179,7 +214,10 @@ func (r *datasourcePermissionResource) Read(ctx context.Context, req resource.Re
 	} else {
 		state.GroupID = types.StringValue(datasourcePermission.EntityID)
 	}
-	state.ID = types.StringValue(getDatasourcePermissionID(datasourcePermission.DatasourceID, datasourcePermission.EntityType, datasourcePermission.EntityID, datasourcePermission.CapabilityName, datasourcePermission.CapabilityMode))
+	state.ID = types.StringValue(getSiteScopedID(getDatasourcePermissionID(datasourcePermission.DatasourceID, datasourcePermission.EntityType, datasourcePermission.EntityID, datasourcePermission.CapabilityName, datasourcePermission.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
 	state.DatasourceID = types.StringValue(datasourcePermission.DatasourceID)
 	state.CapabilityName = types.StringValue(datasourcePermission.CapabilityName)
 	state.CapabilityMode = types.StringValue(datasourcePermission.CapabilityMode)
@@ -214,9 +252,19 @@ func (r *datasourcePermissionResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	permission := getDatasourcePermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getDatasourcePermissionFromID(permissionID)
 	if permission.EntityType == "users" {
-		err := r.client.DeleteDatasourcePermission(&permission.EntityID, nil, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteDatasourcePermission(&permission.EntityID, nil, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Datasource",
@@ -225,7 +273,7 @@ func (r *datasourcePermissionResource) Delete(ctx context.Context, req resource.
 			return
 		}
 	} else {
-		err := r.client.DeleteDatasourcePermission(nil, &permission.EntityID, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteDatasourcePermission(nil, &permission.EntityID, permission.DatasourceID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Datasource",
@@ -245,7 +293,7 @@ func (r *datasourcePermissionResource) Configure(_ context.Context, req resource
 }
 
 func (r *datasourcePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importPermissionState(ctx, r.client, req, resp, "datasource", (*Client).ResolveDatasourceImportID, getDatasourcePermissionID)
 }
 
 func getDatasourcePermissionID(datasourceID, entityType, entityID, capabilityName, capabilityMode string) string {
@@ -0,0 +1,179 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type DatasourceRevision struct {
+	DatasourceID   string
+	Current        bool   `json:"current,omitempty"`
+	Deleted        bool   `json:"deleted,omitempty"`
+	PublishedAt    string `json:"publishedAt,omitempty"`
+	RevisionNumber string `json:"revisionNumber,omitempty"`
+	Publisher      struct {
+		ID string `json:"id,omitempty"`
+		// Name string `json:"name,omitempty"`
+	} `json:"publisher,omitempty"`
+}
+
+type DatasourceRevisionRequest struct {
+	DatasourceRevision DatasourceRevision `json:"datasourceRevisions"`
+}
+
+type DatasourcePublish struct {
+	Name    string `json:"name"`
+	Project Owner  `json:"project"`
+}
+
+type DatasourcePublishRequest struct {
+	Datasource DatasourcePublish `json:"datasource"`
+}
+
+type DatasourceRevisionsResponse struct {
+	DatasourceRevisions []DatasourceRevision `json:"revision"`
+}
+
+type DatasourceRevisionListResponse struct {
+	DatasourceRevisionsResponse DatasourceRevisionsResponse `json:"revisions"`
+	Pagination                  PaginationDetails           `json:"pagination"`
+}
+
+func (c *Client) GetDatasourceRevisions(datasourceID string) ([]DatasourceRevision, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources/%s/revisions", c.ApiUrl, datasourceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	datasourceRevisionsListResponse := DatasourceRevisionListResponse{}
+	err = json.Unmarshal(body, &datasourceRevisionsListResponse)
+	if err != nil {
+		return nil, err
+	}
+	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(datasourceRevisionsListResponse.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	allDatasourceRevisions := make([]DatasourceRevision, 0, totalAvailable)
+	allDatasourceRevisions = append(allDatasourceRevisions, datasourceRevisionsListResponse.DatasourceRevisionsResponse.DatasourceRevisions...)
+	for page := pageNumber + 1; page <= totalPageCount; page++ {
+		req, err = http.NewRequest("GET", fmt.Sprintf("%s/datasources/%s/revisions?pageNumber=%s", c.ApiUrl, datasourceID, strconv.Itoa(page)), nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err = c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		datasourceRevisionsListResponse = DatasourceRevisionListResponse{}
+		err = json.Unmarshal(body, &datasourceRevisionsListResponse)
+		if err != nil {
+			return nil, err
+		}
+		allDatasourceRevisions = append(allDatasourceRevisions, datasourceRevisionsListResponse.DatasourceRevisionsResponse.DatasourceRevisions...)
+	}
+	for idx := range allDatasourceRevisions {
+		allDatasourceRevisions[idx].DatasourceID = datasourceID
+	}
+	return allDatasourceRevisions, nil
+}
+
+func (c *Client) DownloadDatasourceRevision(datasourceID, revisionNumber string) ([]byte, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/datasources/%s/revisions/%s/content", c.ApiUrl, datasourceID, revisionNumber), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doContentRequest(req)
+}
+
+// PublishDatasource publishes datasource content into a project, overwriting any datasource with the same name.
+func (c *Client) PublishDatasource(name, projectID string, content []byte) (*Datasource, error) {
+	publishRequest := DatasourcePublishRequest{
+		Datasource: DatasourcePublish{
+			Name:    name,
+			Project: Owner{ID: projectID},
+		},
+	}
+
+	publishJson, err := json.Marshal(publishRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := name + ".tds"
+	if IsPackagedContent(content) {
+		fileName += "x"
+	}
+	body, err := c.publishContent("datasource", fileName, publishJson, content)
+	if err != nil {
+		return nil, err
+	}
+
+	datasourceResponse := DatasourceResponse{}
+	err = json.Unmarshal(body, &datasourceResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datasourceResponse.Datasource, nil
+}
+
+func (c *Client) GetCurrentDatasourceRevision(datasourceID string) (*DatasourceRevision, error) {
+	revisions, err := c.GetDatasourceRevisions(datasourceID)
+	if err != nil {
+		return nil, err
+	}
+	for i, revision := range revisions {
+		if revision.Current {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find current revision of datasource ID %s", datasourceID)
+}
+
+// RestoreDatasourceRevision republishes the given revision as the current one and returns the resulting current revision.
+// Nothing is published when the given revision is already current.
+func (c *Client) RestoreDatasourceRevision(datasourceID, revisionNumber string) (*DatasourceRevision, error) {
+	revisions, err := c.GetDatasourceRevisions(datasourceID)
+	if err != nil {
+		return nil, err
+	}
+	var targetRevision *DatasourceRevision
+	for i, revision := range revisions {
+		if revision.RevisionNumber == revisionNumber {
+			targetRevision = &revisions[i]
+		}
+	}
+	if targetRevision == nil {
+		return nil, fmt.Errorf("did not find revision %s of datasource ID %s", revisionNumber, datasourceID)
+	}
+	if targetRevision.Current {
+		return targetRevision, nil
+	}
+	if targetRevision.Deleted {
+		return nil, fmt.Errorf("revision %s of datasource ID %s has been deleted", revisionNumber, datasourceID)
+	}
+
+	datasource, err := c.GetDatasource(datasourceID, "")
+	if err != nil {
+		return nil, err
+	}
+	content, err := c.DownloadDatasourceRevision(datasourceID, revisionNumber)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.PublishDatasource(datasource.Name, datasource.Project.ID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCurrentDatasourceRevision(datasourceID)
+}
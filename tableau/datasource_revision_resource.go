@@ -0,0 +1,226 @@
+package tableau
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &datasourceRevisionResource{}
+	_ resource.ResourceWithConfigure   = &datasourceRevisionResource{}
+	_ resource.ResourceWithImportState = &datasourceRevisionResource{}
+	_ resource.ResourceWithModifyPlan  = &datasourceRevisionResource{}
+)
+
+func NewDatasourceRevisionResource() resource.Resource {
+	return &datasourceRevisionResource{}
+}
+
+type datasourceRevisionResource struct {
+	client *Client
+}
+
+type datasourceRevisionResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Site                   types.String `tfsdk:"site"`
+	DatasourceID           types.String `tfsdk:"datasource_id"`
+	RevisionNumber         types.String `tfsdk:"revision_number"`
+	CurrentRevisionNumber  types.String `tfsdk:"current_revision_number"`
+	RestoredRevisionNumber types.String `tfsdk:"restored_revision_number"`
+	LastUpdated            types.String `tfsdk:"last_updated"`
+}
+
+func (r *datasourceRevisionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource_revision"
+}
+
+func (r *datasourceRevisionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pins a published datasource to a revision by downloading that revision and republishing it as current. Newer publishes are reported as drift and rolled back on apply. Destroying the resource leaves the datasource untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": siteAttribute(),
+			"datasource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the datasource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision_number": schema.StringAttribute{
+				Required:    true,
+				Description: "Revision number to restore as current",
+			},
+			"current_revision_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current revision number of the datasource",
+			},
+			"restored_revision_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "Revision number created by the last restore, equal to revision_number when it was already current",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform restore of the datasource",
+			},
+		},
+	}
+}
+
+func (r *datasourceRevisionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
+func (r *datasourceRevisionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan datasourceRevisionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	datasourceID := plan.DatasourceID.ValueString()
+	revision, err := siteClient.RestoreDatasourceRevision(datasourceID, plan.RevisionNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring datasource revision",
+			"Could not restore datasource revision, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(datasourceID)
+	plan.CurrentRevisionNumber = types.StringValue(revision.RevisionNumber)
+	plan.RestoredRevisionNumber = types.StringValue(revision.RevisionNumber)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceRevisionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state datasourceRevisionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	revision, err := siteClient.GetCurrentDatasourceRevision(state.ID.ValueString())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DatasourceID = state.ID
+	state.CurrentRevisionNumber = types.StringValue(revision.RevisionNumber)
+	// An imported resource restored nothing yet, its current revision stands for the restored one
+	if state.RestoredRevisionNumber.IsNull() {
+		state.RevisionNumber = types.StringValue(revision.RevisionNumber)
+		state.RestoredRevisionNumber = types.StringValue(revision.RevisionNumber)
+	}
+	// A newer publish replaced the restored revision, surface it as drift on revision_number
+	if revision.RevisionNumber != state.RestoredRevisionNumber.ValueString() {
+		state.RevisionNumber = types.StringValue(revision.RevisionNumber)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceRevisionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state datasourceRevisionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A site renamed to an equivalent one leaves the pinned revision current, nothing is restored
+	if plan.RevisionNumber.Equal(state.RevisionNumber) {
+		plan.CurrentRevisionNumber = state.CurrentRevisionNumber
+		plan.RestoredRevisionNumber = state.RestoredRevisionNumber
+		plan.LastUpdated = state.LastUpdated
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	revision, err := siteClient.RestoreDatasourceRevision(plan.DatasourceID.ValueString(), plan.RevisionNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Tableau Datasource Revision",
+			"Could not restore datasource revision, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.CurrentRevisionNumber = types.StringValue(revision.RevisionNumber)
+	plan.RestoredRevisionNumber = types.StringValue(revision.RevisionNumber)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *datasourceRevisionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The datasource keeps its current revision, only stop pinning it.
+}
+
+func (r *datasourceRevisionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *datasourceRevisionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSiteContentState(ctx, r.client, req, resp, "datasourceID or project path/datasource name", (*Client).ResolveDatasourceImportID, "datasource_id")
+}
@@ -0,0 +1,129 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &datasourceRevisionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &datasourceRevisionsDataSource{}
+)
+
+func DatasourceRevisionsDataSource() datasource.DataSource {
+	return &datasourceRevisionsDataSource{}
+}
+
+type datasourceRevisionsDataSource struct {
+	client *Client
+}
+
+type datasourceRevisionNestedDataModel struct {
+	PublisherID    types.String `tfsdk:"publisher_id"`
+	Current        types.Bool   `tfsdk:"current"`
+	Deleted        types.Bool   `tfsdk:"deleted"`
+	PublishedAt    types.String `tfsdk:"published_at"`
+	RevisionNumber types.String `tfsdk:"revision_number"`
+}
+
+type datasourceRevisionsDataSourceModel struct {
+	Site      types.String                        `tfsdk:"site"`
+	ID        types.String                        `tfsdk:"id"`
+	Revisions []datasourceRevisionNestedDataModel `tfsdk:"revisions"`
+}
+
+func (d *datasourceRevisionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource_revisions"
+}
+
+func (d *datasourceRevisionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve datasource revisions details",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the datasource",
+			},
+			"revisions": schema.ListNestedAttribute{
+				Description: "List datasource revisions and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"publisher_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user",
+						},
+						"current": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Current revision",
+						},
+						"deleted": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Deleted revision",
+						},
+						"published_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Published at given date",
+						},
+						"revision_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "Revision number",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *datasourceRevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state datasourceRevisionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	revisions, err := siteClient.GetDatasourceRevisions(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Datasource Revisions",
+			err.Error(),
+		)
+		return
+	}
+	for _, revision := range revisions {
+		datasourceRevision := datasourceRevisionNestedDataModel{
+			PublisherID:    types.StringValue(revision.Publisher.ID),
+			Current:        types.BoolValue(revision.Current),
+			Deleted:        types.BoolValue(revision.Deleted),
+			PublishedAt:    types.StringValue(revision.PublishedAt),
+			RevisionNumber: types.StringValue(revision.RevisionNumber),
+		}
+		state.Revisions = append(state.Revisions, datasourceRevision)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *datasourceRevisionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
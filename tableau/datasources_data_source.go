@@ -30,6 +30,7 @@ type datasourcesNestedDataModel struct {
 }
 
 type datasourcesDataSourceModel struct {
+	Site        types.String                 `tfsdk:"site"`
 	ID          types.String                 `tfsdk:"id"`
 	Datasources []datasourcesNestedDataModel `tfsdk:"datasources"`
 }
@@ -42,6 +43,7 @@ func (d *datasourcesDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description: "Retrieve datasource details as a list of datasources available to read",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the list of datasources",
@@ -83,7 +85,16 @@ func (d *datasourcesDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	datasources, err := d.client.GetDatasources()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	datasources, err := siteClient.GetDatasources()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Datasources",
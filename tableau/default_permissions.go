@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 var defaultPermissionTargetTypes = []string{
@@ -18,6 +19,16 @@ var defaultPermissionTargetTypes = []string{
 	"workbooks",
 }
 
+// getDefaultPermissionContentType returns the content type of tableau_permissions that a default permissions target type applies to.
+func getDefaultPermissionContentType(targetType string) string {
+	for contentType, contentPath := range permissionContentTypes {
+		if contentPath == targetType {
+			return contentType
+		}
+	}
+	return ""
+}
+
 func (c *Client) GetDefaultPermissions(projectID, targetType string) (*ProjectPermissions, error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/default-permissions/%s", c.ApiUrl, projectID, targetType), nil)
 	if err != nil {
@@ -35,3 +46,46 @@ func (c *Client) GetDefaultPermissions(projectID, targetType string) (*ProjectPe
 	}
 	return &projectPermissionsResponse.ProjectPermissions, nil
 }
+
+func (c *Client) CreateDefaultPermissions(projectID, targetType string, projectPermissions ProjectPermissions) (*ProjectPermissions, error) {
+	projectPermissionsRequest := ProjectPermissionsRequest{
+		ProjectPermissions: projectPermissions,
+	}
+
+	newProjectPermissionsJson, err := json.Marshal(projectPermissionsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/projects/%s/default-permissions/%s", c.ApiUrl, projectID, targetType), strings.NewReader(string(newProjectPermissionsJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	projectPermissionsResponse := ProjectPermissionsResponse{}
+	err = json.Unmarshal(body, &projectPermissionsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectPermissionsResponse.ProjectPermissions, nil
+}
+
+func (c *Client) DeleteDefaultPermission(projectID, targetType, entityType, entityID, capabilityName, capabilityMode string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/default-permissions/%s/%s/%s/%s/%s", c.ApiUrl, projectID, targetType, entityType, entityID, capabilityName, capabilityMode), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
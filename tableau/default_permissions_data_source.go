@@ -34,6 +34,7 @@ type GranteeCapabilityModel struct {
 }
 
 type defaultPermissionsDataSourceModel struct {
+	Site                types.String             `tfsdk:"site"`
 	ProjectID           types.String             `tfsdk:"project_id"`
 	TargetType          types.String             `tfsdk:"target_type"`
 	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
@@ -47,6 +48,7 @@ func (d *defaultPermissionsDataSource) Schema(_ context.Context, _ datasource.Sc
 	resp.Schema = schema.Schema{
 		Description: "Retrieve project details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"project_id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the project",
@@ -96,7 +98,16 @@ func (d *defaultPermissionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	perms, err := d.client.GetDefaultPermissions(state.ProjectID.ValueString(), state.TargetType.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	perms, err := siteClient.GetDefaultPermissions(state.ProjectID.ValueString(), state.TargetType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project Permissions",
@@ -0,0 +1,130 @@
+package tableau
+
+import (
+	"slices"
+)
+
+// PermissionsContext holds everything needed to evaluate the permissions of one user on one content item.
+type PermissionsContext struct {
+	UserID   string
+	SiteRole string
+	GroupIDs []string
+
+	ContentType        string
+	ContentOwnerID     string
+	ContentPermissions []GranteeCapability
+
+	// Containing project of the content item, the project itself when evaluating a project
+	ProjectOwnerID            string
+	ProjectContentPermissions string
+	ProjectPermissions        []GranteeCapability
+	// Default permissions of the containing project for ContentType, used instead of ContentPermissions when the project is locked
+	ProjectDefaultPermissions []GranteeCapability
+
+	// Workbook of a view, whose permissions are used instead of ContentPermissions when the workbook shows its views as tabs
+	WorkbookShowTabs    bool
+	WorkbookPermissions []GranteeCapability
+}
+
+// EffectiveCapability is the outcome for a single capability, with the rule that decided it.
+type EffectiveCapability struct {
+	Name        string
+	Allowed     bool
+	Explanation string
+}
+
+// IsManagedByOwner reports whether the owners of content items manage their permissions, which gives them every capability.
+func (p PermissionsContext) IsManagedByOwner() bool {
+	return p.ProjectContentPermissions == "ManagedByOwner"
+}
+
+// IsLocked reports whether the permissions of content items are locked to their project.
+func (p PermissionsContext) IsLocked() bool {
+	return p.ContentType != "project" && (p.ProjectContentPermissions == "LockedToProject" || p.ProjectContentPermissions == "LockedToProjectWithoutNested")
+}
+
+// EvaluateEffectivePermissions decides every capability of the content type, applying in order:
+// license caps of the site role, site administrators, content owner when the project is managed by owner, project owner and project leaders,
+// then the explicit grants where user rules beat group rules and Deny beats Allow.
+// The grants of a locked project are its default permissions, and the grants of a view shown as a tab are those of its workbook.
+func EvaluateEffectivePermissions(p PermissionsContext) []EffectiveCapability {
+	effective := []EffectiveCapability{}
+	for _, capability := range contentTypeCapabilities[p.ContentType] {
+		allowed, explanation := p.evaluateCapability(capability)
+		effective = append(effective, EffectiveCapability{
+			Name:        capability,
+			Allowed:     allowed,
+			Explanation: explanation,
+		})
+	}
+	return effective
+}
+
+func (p PermissionsContext) evaluateCapability(capability string) (bool, string) {
+	if !SiteRoleAllowsCapability(p.SiteRole, capability) {
+		return false, "denied: the " + p.SiteRole + " site role does not allow " + capability
+	}
+	if slices.Contains(administratorSiteRoles, p.SiteRole) {
+		return true, "allowed: " + p.SiteRole + " has all capabilities"
+	}
+	if p.ContentOwnerID != "" && p.ContentOwnerID == p.UserID && p.IsManagedByOwner() {
+		return true, "allowed: user owns the " + p.ContentType
+	}
+	if p.ProjectOwnerID != "" && p.ProjectOwnerID == p.UserID {
+		return true, "allowed: user owns the project"
+	}
+	if mode, _ := p.resolveGrants(p.ProjectPermissions, "ProjectLeader"); mode == "Allow" {
+		return true, "allowed: user is project leader"
+	}
+
+	permissions := p.ContentPermissions
+	source := "the " + p.ContentType + " permissions"
+	switch {
+	case p.IsLocked():
+		permissions = p.ProjectDefaultPermissions
+		source = "the default permissions of the locked project"
+	case p.ContentType == "view" && p.WorkbookShowTabs:
+		permissions = p.WorkbookPermissions
+		source = "the workbook permissions, as the view is shown as a tab"
+	}
+	mode, grantee := p.resolveGrants(permissions, capability)
+	switch mode {
+	case "Allow":
+		return true, "allowed: " + grantee + " is allowed " + capability + " by " + source
+	case "Deny":
+		return false, "denied: " + grantee + " is denied " + capability + " by " + source
+	}
+	return false, "denied: " + capability + " is not granted by " + source
+}
+
+// resolveGrants returns the winning mode of a capability in a permissions document and who it was granted to.
+// A user rule takes precedence over group rules, and Deny takes precedence over Allow at the same level.
+func (p PermissionsContext) resolveGrants(permissions []GranteeCapability, capability string) (string, string) {
+	userMode := ""
+	groupMode := ""
+	for _, g := range GetGrantedCapabilities(permissions) {
+		if g.CapabilityName != capability {
+			continue
+		}
+		if g.EntityType == "users" && g.EntityID == p.UserID {
+			userMode = mergeCapabilityMode(userMode, g.CapabilityMode)
+		}
+		if g.EntityType == "groups" && slices.Contains(p.GroupIDs, g.EntityID) {
+			groupMode = mergeCapabilityMode(groupMode, g.CapabilityMode)
+		}
+	}
+	if userMode != "" {
+		return userMode, "user"
+	}
+	if groupMode != "" {
+		return groupMode, "a group of the user"
+	}
+	return "", ""
+}
+
+func mergeCapabilityMode(current, mode string) string {
+	if current == "Deny" || mode == "Deny" {
+		return "Deny"
+	}
+	return mode
+}
@@ -0,0 +1,268 @@
+package tableau
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &effectivePermissionsDataSource{}
+)
+
+// effectivePermissionsContentTypes maps the content types that can be evaluated to the default permissions applying to them in a locked project.
+var effectivePermissionsContentTypes = map[string]string{
+	"datasource":         "datasources",
+	"project":            "",
+	"view":               "workbooks",
+	"virtual_connection": "virtualconnections",
+	"workbook":           "workbooks",
+}
+
+func EffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+type effectivePermissionsDataSource struct {
+	client *Client
+}
+
+type effectiveCapabilityModel struct {
+	Name        types.String `tfsdk:"name"`
+	Allowed     types.Bool   `tfsdk:"allowed"`
+	Explanation types.String `tfsdk:"explanation"`
+}
+
+type effectivePermissionsDataSourceModel struct {
+	Site                types.String               `tfsdk:"site"`
+	UserID              types.String               `tfsdk:"user_id"`
+	ContentType         types.String               `tfsdk:"content_type"`
+	ContentID           types.String               `tfsdk:"content_id"`
+	SiteRole            types.String               `tfsdk:"site_role"`
+	AllowedCapabilities []types.String             `tfsdk:"allowed_capabilities"`
+	Capabilities        []effectiveCapabilityModel `tfsdk:"capabilities"`
+}
+
+func (d *effectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+func (d *effectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	contentTypes := []string{}
+	for contentType := range effectivePermissionsContentTypes {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	resp.Schema = schema.Schema{
+		Description: "Evaluate the capabilities a user effectively has on a content item, from its permissions, the containing project, ownership, group membership and site role",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the user",
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the content item, one of " + strings.Join(contentTypes, "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(contentTypes...),
+				},
+			},
+			"content_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the content item",
+			},
+			"site_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "Site role of the user",
+			},
+			"allowed_capabilities": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the capabilities the user effectively has",
+			},
+			"capabilities": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Every capability of the content type with the rule that decided it",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the capability",
+						},
+						"allowed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the user effectively has the capability",
+						},
+						"explanation": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule that allowed or denied the capability",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *effectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state effectivePermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	permissionsContext, err := getPermissionsContext(siteClient, state.UserID.ValueString(), state.ContentType.ValueString(), state.ContentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Effective Permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.SiteRole = types.StringValue(permissionsContext.SiteRole)
+	state.AllowedCapabilities = []types.String{}
+	state.Capabilities = []effectiveCapabilityModel{}
+	for _, capability := range EvaluateEffectivePermissions(*permissionsContext) {
+		if capability.Allowed {
+			state.AllowedCapabilities = append(state.AllowedCapabilities, types.StringValue(capability.Name))
+		}
+		state.Capabilities = append(state.Capabilities, effectiveCapabilityModel{
+			Name:        types.StringValue(capability.Name),
+			Allowed:     types.BoolValue(capability.Allowed),
+			Explanation: types.StringValue(capability.Explanation),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *effectivePermissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
+
+// getPermissionsContext fetches the user, the content item, the workbook of a view and the containing project.
+func getPermissionsContext(client *Client, userID, contentType, contentID string) (*PermissionsContext, error) {
+	user, err := client.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.GetUserGroups(userID)
+	if err != nil {
+		return nil, err
+	}
+	permissionsContext := PermissionsContext{
+		UserID:      userID,
+		SiteRole:    user.SiteRole,
+		GroupIDs:    []string{},
+		ContentType: contentType,
+	}
+	for _, group := range groups {
+		permissionsContext.GroupIDs = append(permissionsContext.GroupIDs, group.ID)
+	}
+
+	var projectID string
+	switch contentType {
+	case "project":
+		projectID = contentID
+	case "workbook":
+		workbook, err := client.GetWorkbook(contentID)
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.ContentOwnerID = workbook.Owner.ID
+		projectID = workbook.Project.ID
+	case "datasource":
+		datasource, err := client.GetDatasource(contentID, "")
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.ContentOwnerID = datasource.Owner.ID
+		projectID = datasource.Project.ID
+	case "view":
+		view, err := client.GetView(contentID)
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.ContentOwnerID = view.Owner.ID
+		projectID = view.Project.ID
+		workbook, err := client.GetWorkbook(view.Workbook.ID)
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.WorkbookShowTabs, _ = strconv.ParseBool(workbook.ShowTabs)
+		if permissionsContext.WorkbookShowTabs {
+			workbookPermissions, err := client.GetPermissions("workbook", workbook.ID)
+			if err != nil {
+				return nil, err
+			}
+			permissionsContext.WorkbookPermissions = workbookPermissions.GranteeCapabilities
+		}
+	case "virtual_connection":
+		virtualConnection, err := client.GetVirtualConnection(contentID)
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.ContentOwnerID = virtualConnection.Owner.ID
+		projectID = virtualConnection.Project.ID
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	permissionsContext.ProjectOwnerID = project.Owner.ID
+	permissionsContext.ProjectContentPermissions = project.ContentPermissions
+	projectPermissions, err := client.GetProjectPermissions(projectID)
+	if err != nil {
+		return nil, err
+	}
+	permissionsContext.ProjectPermissions = projectPermissions.GranteeCapabilities
+
+	if contentType == "project" {
+		permissionsContext.ContentOwnerID = project.Owner.ID
+		permissionsContext.ContentPermissions = projectPermissions.GranteeCapabilities
+		return &permissionsContext, nil
+	}
+
+	contentPermissions, err := client.GetPermissions(contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+	permissionsContext.ContentPermissions = contentPermissions.GranteeCapabilities
+	if permissionsContext.IsLocked() {
+		defaultPermissions, err := client.GetDefaultPermissions(projectID, effectivePermissionsContentTypes[contentType])
+		if err != nil {
+			return nil, err
+		}
+		permissionsContext.ProjectDefaultPermissions = defaultPermissions.GranteeCapabilities
+	}
+	return &permissionsContext, nil
+}
@@ -0,0 +1,295 @@
+package tableau
+
+import (
+	"testing"
+)
+
+func testGrant(entityType, entityID, capabilityName, capabilityMode string) GranteeCapability {
+	return GetGranteeCapabilities([]GrantedCapability{{
+		EntityType:     entityType,
+		EntityID:       entityID,
+		CapabilityName: capabilityName,
+		CapabilityMode: capabilityMode,
+	}})[0]
+}
+
+func TestEvaluateEffectivePermissions(t *testing.T) {
+	base := PermissionsContext{
+		UserID:                    "alice",
+		SiteRole:                  "Creator",
+		GroupIDs:                  []string{"analysts", "finance"},
+		ContentType:               "workbook",
+		ContentOwnerID:            "bob",
+		ProjectOwnerID:            "bob",
+		ProjectContentPermissions: "ManagedByOwner",
+	}
+
+	tests := []struct {
+		name       string
+		update     func(p *PermissionsContext)
+		capability string
+		allowed    bool
+	}{
+		{
+			name:       "nothing granted",
+			update:     func(p *PermissionsContext) {},
+			capability: "Read",
+			allowed:    false,
+		},
+		{
+			name: "group allow",
+			update: func(p *PermissionsContext) {
+				p.ContentPermissions = []GranteeCapability{testGrant("groups", "analysts", "ExportData", "Allow")}
+			},
+			capability: "ExportData",
+			allowed:    true,
+		},
+		{
+			name: "group deny beats group allow",
+			update: func(p *PermissionsContext) {
+				p.ContentPermissions = []GranteeCapability{
+					testGrant("groups", "analysts", "ExportData", "Allow"),
+					testGrant("groups", "finance", "ExportData", "Deny"),
+				}
+			},
+			capability: "ExportData",
+			allowed:    false,
+		},
+		{
+			name: "user allow beats group deny",
+			update: func(p *PermissionsContext) {
+				p.ContentPermissions = []GranteeCapability{
+					testGrant("groups", "finance", "ExportData", "Deny"),
+					testGrant("users", "alice", "ExportData", "Allow"),
+				}
+			},
+			capability: "ExportData",
+			allowed:    true,
+		},
+		{
+			name: "user deny beats group allow",
+			update: func(p *PermissionsContext) {
+				p.ContentPermissions = []GranteeCapability{
+					testGrant("groups", "analysts", "Read", "Allow"),
+					testGrant("users", "alice", "Read", "Deny"),
+				}
+			},
+			capability: "Read",
+			allowed:    false,
+		},
+		{
+			name: "grants of other users are ignored",
+			update: func(p *PermissionsContext) {
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "carol", "Read", "Allow")}
+			},
+			capability: "Read",
+			allowed:    false,
+		},
+		{
+			name: "locked project uses default permissions",
+			update: func(p *PermissionsContext) {
+				p.ProjectContentPermissions = "LockedToProject"
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "alice", "Read", "Allow")}
+				p.ProjectDefaultPermissions = []GranteeCapability{testGrant("groups", "finance", "Read", "Deny")}
+			},
+			capability: "Read",
+			allowed:    false,
+		},
+		{
+			name: "locked project grants from default permissions",
+			update: func(p *PermissionsContext) {
+				p.ProjectContentPermissions = "LockedToProjectWithoutNested"
+				p.ProjectDefaultPermissions = []GranteeCapability{testGrant("groups", "finance", "Read", "Allow")}
+			},
+			capability: "Read",
+			allowed:    true,
+		},
+		{
+			name: "content owner",
+			update: func(p *PermissionsContext) {
+				p.ContentOwnerID = "alice"
+			},
+			capability: "Delete",
+			allowed:    true,
+		},
+		{
+			name: "content owner of a locked project",
+			update: func(p *PermissionsContext) {
+				p.ContentOwnerID = "alice"
+				p.ProjectContentPermissions = "LockedToProject"
+			},
+			capability: "Delete",
+			allowed:    false,
+		},
+		{
+			name: "content owner granted by a locked project",
+			update: func(p *PermissionsContext) {
+				p.ContentOwnerID = "alice"
+				p.ProjectContentPermissions = "LockedToProject"
+				p.ProjectDefaultPermissions = []GranteeCapability{testGrant("users", "alice", "Delete", "Allow")}
+			},
+			capability: "Delete",
+			allowed:    true,
+		},
+		{
+			name: "view shown as tab uses workbook permissions",
+			update: func(p *PermissionsContext) {
+				p.ContentType = "view"
+				p.WorkbookShowTabs = true
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "alice", "ExportData", "Allow")}
+				p.WorkbookPermissions = []GranteeCapability{testGrant("groups", "finance", "ExportData", "Deny")}
+			},
+			capability: "ExportData",
+			allowed:    false,
+		},
+		{
+			name: "view shown as tab granted by workbook permissions",
+			update: func(p *PermissionsContext) {
+				p.ContentType = "view"
+				p.WorkbookShowTabs = true
+				p.WorkbookPermissions = []GranteeCapability{testGrant("groups", "analysts", "ExportData", "Allow")}
+			},
+			capability: "ExportData",
+			allowed:    true,
+		},
+		{
+			name: "view not shown as tab uses view permissions",
+			update: func(p *PermissionsContext) {
+				p.ContentType = "view"
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "alice", "ExportData", "Allow")}
+				p.WorkbookPermissions = []GranteeCapability{testGrant("groups", "finance", "ExportData", "Deny")}
+			},
+			capability: "ExportData",
+			allowed:    true,
+		},
+		{
+			name: "view of a locked project uses default workbook permissions",
+			update: func(p *PermissionsContext) {
+				p.ContentType = "view"
+				p.ProjectContentPermissions = "LockedToProject"
+				p.WorkbookShowTabs = true
+				p.WorkbookPermissions = []GranteeCapability{testGrant("users", "alice", "Read", "Allow")}
+				p.ProjectDefaultPermissions = []GranteeCapability{testGrant("groups", "finance", "Read", "Deny")}
+			},
+			capability: "Read",
+			allowed:    false,
+		},
+		{
+			name: "project owner",
+			update: func(p *PermissionsContext) {
+				p.ProjectOwnerID = "alice"
+			},
+			capability: "ChangePermissions",
+			allowed:    true,
+		},
+		{
+			name: "project leader through group",
+			update: func(p *PermissionsContext) {
+				p.ProjectPermissions = []GranteeCapability{testGrant("groups", "analysts", "ProjectLeader", "Allow")}
+			},
+			capability: "Write",
+			allowed:    true,
+		},
+		{
+			name: "project leader denied to user",
+			update: func(p *PermissionsContext) {
+				p.ProjectPermissions = []GranteeCapability{
+					testGrant("groups", "analysts", "ProjectLeader", "Allow"),
+					testGrant("users", "alice", "ProjectLeader", "Deny"),
+				}
+			},
+			capability: "Write",
+			allowed:    false,
+		},
+		{
+			name: "site administrator",
+			update: func(p *PermissionsContext) {
+				p.SiteRole = "SiteAdministratorCreator"
+			},
+			capability: "WebAuthoring",
+			allowed:    true,
+		},
+		{
+			name: "viewer license caps allow",
+			update: func(p *PermissionsContext) {
+				p.SiteRole = "Viewer"
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "alice", "WebAuthoring", "Allow")}
+			},
+			capability: "WebAuthoring",
+			allowed:    false,
+		},
+		{
+			name: "viewer license caps owner",
+			update: func(p *PermissionsContext) {
+				p.SiteRole = "Viewer"
+				p.ContentOwnerID = "alice"
+			},
+			capability: "Write",
+			allowed:    false,
+		},
+		{
+			name: "explorer cannot overwrite",
+			update: func(p *PermissionsContext) {
+				p.SiteRole = "Explorer"
+				p.ContentPermissions = []GranteeCapability{testGrant("users", "alice", "Write", "Allow")}
+			},
+			capability: "Write",
+			allowed:    false,
+		},
+		{
+			name: "unlicensed has nothing",
+			update: func(p *PermissionsContext) {
+				p.SiteRole = "Unlicensed"
+				p.ContentOwnerID = "alice"
+			},
+			capability: "Read",
+			allowed:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := base
+			tt.update(&p)
+			found := false
+			for _, capability := range EvaluateEffectivePermissions(p) {
+				if capability.Name != tt.capability {
+					continue
+				}
+				found = true
+				if capability.Allowed != tt.allowed {
+					t.Errorf("%s: expected allowed=%t, got %t (%s)", tt.capability, tt.allowed, capability.Allowed, capability.Explanation)
+				}
+				if capability.Explanation == "" {
+					t.Errorf("%s: missing explanation", tt.capability)
+				}
+			}
+			if !found {
+				t.Errorf("%s: capability not evaluated for %s", tt.capability, p.ContentType)
+			}
+		})
+	}
+}
+
+func TestSiteRoleAllowsCapability(t *testing.T) {
+	tests := []struct {
+		siteRole   string
+		capability string
+		allowed    bool
+	}{
+		{"Creator", "WebAuthoringForFlows", true},
+		{"ExplorerCanPublish", "WebAuthoringForFlows", false},
+		{"ExplorerCanPublish", "Write", true},
+		{"Explorer", "SaveAs", false},
+		{"Explorer", "WebAuthoring", true},
+		{"Viewer", "Read", true},
+		{"Viewer", "ExportXml", false},
+		{"Unlicensed", "Read", false},
+		{"SomeFutureRole", "Write", true},
+	}
+	for _, tt := range tests {
+		if got := SiteRoleAllowsCapability(tt.siteRole, tt.capability); got != tt.allowed {
+			t.Errorf("SiteRoleAllowsCapability(%s, %s) = %t, expected %t", tt.siteRole, tt.capability, got, tt.allowed)
+		}
+	}
+}
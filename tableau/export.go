@@ -0,0 +1,408 @@
+package tableau
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// allUsersGroupName is the built-in group every user of a site belongs to, it cannot be created or deleted.
+const allUsersGroupName = "All Users"
+
+var terraformNameInvalidCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// siteExporter generates the configuration of a site, remembering the address of every exported resource
+// so that the resources depending on it reference it instead of repeating its ID.
+type siteExporter struct {
+	client           *Client
+	users            []User
+	projectTree      []ProjectTreeNode
+	names            map[string]map[string]bool
+	userAddresses    map[string]string
+	groupAddresses   map[string]string
+	projectAddresses map[string]string
+}
+
+// hclAttribute is an attribute of a generated block with its value already rendered as an HCL expression.
+type hclAttribute struct {
+	name  string
+	value string
+}
+
+// ExportSite writes the Terraform configuration of the site of the client to dir: its settings, users, groups, memberships,
+// projects, project permissions and default permissions, each resource followed by the import block adopting it.
+// The content of the projects and its permissions are not exported.
+func ExportSite(client *Client, dir string) error {
+	e := &siteExporter{
+		client:           client,
+		names:            map[string]map[string]bool{},
+		userAddresses:    map[string]string{},
+		groupAddresses:   map[string]string{},
+		projectAddresses: map[string]string{},
+	}
+
+	files := []struct {
+		name   string
+		export func() (string, error)
+	}{
+		{name: "site.tf", export: e.exportSite},
+		{name: "users.tf", export: e.exportUsers},
+		{name: "groups.tf", export: e.exportGroups},
+		{name: "group_users.tf", export: e.exportGroupUsers},
+		{name: "projects.tf", export: e.exportProjects},
+		{name: "permissions.tf", export: e.exportPermissions},
+		{name: "default_permissions.tf", export: e.exportDefaultPermissions},
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := file.export()
+		if err != nil {
+			return fmt.Errorf("could not export %s: %w", file.name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file.name), []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *siteExporter) exportSite() (string, error) {
+	site, err := e.client.GetSite(e.client.SiteID)
+	if err != nil {
+		return "", err
+	}
+
+	attributes := []hclAttribute{
+		{name: "name", value: hclString(site.Name)},
+		{name: "content_url", value: hclString(site.ContentURL)},
+		{name: "admin_mode", value: hclOptionalString(site.AdminMode)},
+		{name: "state", value: hclOptionalString(site.State)},
+		{name: "user_quota", value: hclOptionalNumber(site.UserQuota)},
+		{name: "storage_quota", value: hclOptionalNumber(site.StorageQuota)},
+		{name: "revision_history_enabled", value: hclOptionalBool(site.RevisionHistoryEnabled)},
+		{name: "revision_limit", value: hclOptionalNumber(site.RevisionLimit)},
+		{name: "disable_subscriptions", value: hclOptionalBool(site.DisableSubscriptions)},
+		{name: "flows_enabled", value: hclOptionalBool(site.FlowsEnabled)},
+		{name: "commenting_enabled", value: hclOptionalBool(site.CommentingEnabled)},
+		{name: "data_alerts_enabled", value: hclOptionalBool(site.DataAlertsEnabled)},
+		{name: "cataloging_enabled", value: hclOptionalBool(site.CatalogingEnabled)},
+		{name: "explain_data_enabled", value: hclOptionalBool(site.ExplainDataEnabled)},
+		{name: "extract_encryption_mode", value: hclOptionalString(site.ExtractEncryptionMode)},
+		{name: "derived_permissions_enabled", value: hclOptionalBool(site.DerivedPermissionsEnabled)},
+		{name: "personal_space_enabled", value: hclOptionalBool(site.PersonalSpaceEnabled)},
+		{name: "personal_space_storage_quota", value: hclOptionalNumber(site.PersonalSpaceStorageQuota)},
+		{name: "recycle_bin_enabled", value: hclOptionalBool(site.RecycleBinEnabled)},
+		{name: "deletion_protection", value: "true"},
+	}
+
+	b := &strings.Builder{}
+	writeHCLResource(b, "tableau_site", e.getUniqueName("tableau_site", site.Name, "site"), site.ID, attributes)
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportUsers() (string, error) {
+	users, err := e.client.GetUsers()
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+	e.users = users
+
+	b := &strings.Builder{}
+	for _, user := range users {
+		authSetting := user.AuthSetting
+		if authSetting == "" {
+			authSetting = "ServerDefault"
+		}
+		name := e.getUniqueName("tableau_user", user.Name, "user")
+		e.userAddresses[user.ID] = "tableau_user." + name
+		writeHCLResource(b, "tableau_user", name, user.ID, []hclAttribute{
+			{name: "name", value: hclString(user.Name)},
+			{name: "email", value: hclString(user.Email)},
+			{name: "full_name", value: hclString(user.FullName)},
+			{name: "site_role", value: hclString(user.SiteRole)},
+			{name: "auth_setting", value: hclString(authSetting)},
+		})
+	}
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportGroups() (string, error) {
+	groups, err := e.client.GetGroups()
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	b := &strings.Builder{}
+	for _, group := range groups {
+		if group.Name == allUsersGroupName {
+			continue
+		}
+		name := e.getUniqueName("tableau_group", group.Name, "group")
+		e.groupAddresses[group.ID] = "tableau_group." + name
+		writeHCLResource(b, "tableau_group", name, group.ID, []hclAttribute{
+			{name: "name", value: hclString(group.Name)},
+			{name: "minimum_site_role", value: hclOptionalString(group.MinimumSiteRole)},
+		})
+	}
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportGroupUsers() (string, error) {
+	b := &strings.Builder{}
+	for _, user := range e.users {
+		groups, err := e.client.GetUserGroups(user.ID)
+		if err != nil {
+			return "", err
+		}
+		sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+		for _, group := range groups {
+			if group.Name == allUsersGroupName {
+				continue
+			}
+			name := e.getUniqueName("tableau_group_user", group.Name+"_"+user.Name, "group_user")
+			writeHCLResource(b, "tableau_group_user", name, GetCombinedID(group.ID, user.ID), []hclAttribute{
+				{name: "group_id", value: e.getReference(e.groupAddresses, group.ID)},
+				{name: "user_id", value: e.getReference(e.userAddresses, user.ID)},
+			})
+		}
+	}
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportProjects() (string, error) {
+	nodes, err := e.client.GetProjectTree()
+	if err != nil {
+		return "", err
+	}
+	e.projectTree = nodes
+
+	b := &strings.Builder{}
+	// The tree is sorted by path, so parent projects are exported before their children
+	for _, node := range nodes {
+		project := node.Project
+		name := e.getUniqueName("tableau_project", node.Path, "project")
+		e.projectAddresses[project.ID] = "tableau_project." + name
+		attributes := []hclAttribute{
+			{name: "name", value: hclString(project.Name)},
+			{name: "description", value: hclOptionalString(project.Description)},
+			{name: "content_permissions", value: hclString(project.ContentPermissions)},
+		}
+		if project.ParentProjectID != "" {
+			attributes = append(attributes, hclAttribute{name: "parent_project_id", value: e.getReference(e.projectAddresses, project.ParentProjectID)})
+		}
+		if project.Owner.ID != "" {
+			attributes = append(attributes, hclAttribute{name: "owner_id", value: e.getReference(e.userAddresses, project.Owner.ID)})
+		}
+		attributes = append(attributes, hclAttribute{name: "deletion_protection", value: "true"})
+		writeHCLResource(b, "tableau_project", name, project.ID, attributes)
+	}
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportPermissions() (string, error) {
+	b := &strings.Builder{}
+	for _, node := range e.projectTree {
+		perms, err := e.client.GetProjectPermissions(node.Project.ID)
+		if err != nil {
+			return "", err
+		}
+		if len(perms.GranteeCapabilities) == 0 {
+			continue
+		}
+		name := e.getUniqueName("tableau_permissions", node.Path, "project")
+		writeHCLResource(b, "tableau_permissions", name, GetCombinedID("project", node.Project.ID), []hclAttribute{
+			{name: "content_type", value: hclString("project")},
+			{name: "content_id", value: e.getReference(e.projectAddresses, node.Project.ID)},
+			{name: "grantee_capabilities", value: e.getGranteeCapabilitiesValue(perms.GranteeCapabilities)},
+		})
+	}
+	return b.String(), nil
+}
+
+func (e *siteExporter) exportDefaultPermissions() (string, error) {
+	b := &strings.Builder{}
+	for _, node := range e.projectTree {
+		for _, targetType := range defaultPermissionTargetTypes {
+			perms, err := e.client.GetDefaultPermissions(node.Project.ID, targetType)
+			if isUnavailableTargetTypeError(err) {
+				// Not every content type is available on every server version and edition
+				fmt.Fprintf(b, "# Skipped %s default permissions of project %s: %s\n\n", targetType, node.Path, strings.ReplaceAll(err.Error(), "\n", " "))
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+			if len(perms.GranteeCapabilities) == 0 {
+				continue
+			}
+			name := e.getUniqueName("tableau_project_default_permissions", node.Path+"_"+targetType, "project")
+			writeHCLResource(b, "tableau_project_default_permissions", name, GetCombinedID(node.Project.ID, targetType), []hclAttribute{
+				{name: "project_id", value: e.getReference(e.projectAddresses, node.Project.ID)},
+				{name: "target_type", value: hclString(targetType)},
+				{name: "grantee_capabilities", value: e.getGranteeCapabilitiesValue(perms.GranteeCapabilities)},
+			})
+		}
+	}
+	return b.String(), nil
+}
+
+// isUnavailableTargetTypeError reports whether the server rejected the default permissions of a target type it does not support.
+func isUnavailableTargetTypeError(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusBadRequest || statusErr.StatusCode == http.StatusNotFound)
+}
+
+// getGranteeCapabilitiesValue renders a permissions document as the value of a grantee_capabilities attribute, one entry per grantee.
+func (e *siteExporter) getGranteeCapabilitiesValue(granteeCapabilities []GranteeCapability) string {
+	type grantee struct {
+		attribute    string
+		value        string
+		capabilities []string
+	}
+	grantees := []*grantee{}
+	granteesByID := map[string]*grantee{}
+	for _, g := range GetGrantedCapabilities(granteeCapabilities) {
+		key := g.EntityType + "/" + g.EntityID
+		if _, ok := granteesByID[key]; !ok {
+			granteesByID[key] = &grantee{attribute: "user_id", value: e.getReference(e.userAddresses, g.EntityID)}
+			if g.EntityType == "groups" {
+				granteesByID[key] = &grantee{attribute: "group_id", value: e.getReference(e.groupAddresses, g.EntityID)}
+			}
+			grantees = append(grantees, granteesByID[key])
+		}
+		granteesByID[key].capabilities = append(granteesByID[key].capabilities,
+			fmt.Sprintf("{ name = %s, mode = %s }", hclString(g.CapabilityName), hclString(g.CapabilityMode)))
+	}
+	sort.Slice(grantees, func(i, j int) bool {
+		if grantees[i].attribute != grantees[j].attribute {
+			return grantees[i].attribute < grantees[j].attribute
+		}
+		return grantees[i].value < grantees[j].value
+	})
+
+	b := &strings.Builder{}
+	b.WriteString("[\n")
+	for _, g := range grantees {
+		sort.Strings(g.capabilities)
+		b.WriteString("    {\n")
+		fmt.Fprintf(b, "      %-12s = %s\n", g.attribute, g.value)
+		b.WriteString("      capabilities = [\n")
+		for _, capability := range g.capabilities {
+			fmt.Fprintf(b, "        %s,\n", capability)
+		}
+		b.WriteString("      ]\n")
+		b.WriteString("    },\n")
+	}
+	b.WriteString("  ]")
+	return b.String()
+}
+
+// getReference returns the id attribute of the exported resource with the given ID, or the quoted ID when it was not exported.
+func (e *siteExporter) getReference(addresses map[string]string, id string) string {
+	if address, ok := addresses[id]; ok {
+		return address + ".id"
+	}
+	return hclString(id)
+}
+
+// getUniqueName returns a valid Terraform name for the resource, suffixed with a number when the name is already used by the resource type.
+func (e *siteExporter) getUniqueName(resourceType, name, fallback string) string {
+	if e.names[resourceType] == nil {
+		e.names[resourceType] = map[string]bool{}
+	}
+	base := getTerraformName(name, fallback)
+	unique := base
+	for i := 2; e.names[resourceType][unique]; i++ {
+		unique = base + "_" + strconv.Itoa(i)
+	}
+	e.names[resourceType][unique] = true
+	return unique
+}
+
+// getTerraformName turns a name into a Terraform resource name made of lowercase letters, digits and underscores.
+func getTerraformName(name, fallback string) string {
+	name = strings.Trim(terraformNameInvalidCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return fallback
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return fallback + "_" + name
+	}
+	return name
+}
+
+// writeHCLResource writes the import block adopting a resource followed by the resource block.
+// Attributes without value are left out and the equal signs of single line attributes are aligned like terraform fmt does.
+func writeHCLResource(b *strings.Builder, resourceType, name, importID string, attributes []hclAttribute) {
+	fmt.Fprintf(b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, name, hclString(importID))
+	fmt.Fprintf(b, "resource %s %s {\n", hclString(resourceType), hclString(name))
+
+	singleLine := []hclAttribute{}
+	multiLine := []hclAttribute{}
+	width := 0
+	for _, attribute := range attributes {
+		switch {
+		case attribute.value == "":
+			continue
+		case strings.Contains(attribute.value, "\n"):
+			multiLine = append(multiLine, attribute)
+		default:
+			singleLine = append(singleLine, attribute)
+			width = max(width, len(attribute.name))
+		}
+	}
+	for _, attribute := range singleLine {
+		fmt.Fprintf(b, "  %-*s = %s\n", width, attribute.name, attribute.value)
+	}
+	for _, attribute := range multiLine {
+		fmt.Fprintf(b, "\n  %s = %s\n", attribute.name, attribute.value)
+	}
+	b.WriteString("}\n\n")
+}
+
+// hclString quotes a string for HCL, escaping the template sequences so the value is taken literally.
+func hclString(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func hclOptionalString(value string) string {
+	if value == "" {
+		return ""
+	}
+	return hclString(value)
+}
+
+func hclOptionalBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+// hclOptionalNumber renders a numeric setting the API returns as a string, see getSiteInt64Value.
+func hclOptionalNumber(value string) string {
+	if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,156 @@
+package tableau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetTerraformName(t *testing.T) {
+	tests := map[string]string{
+		"Finance/EMEA/Reports": "finance_emea_reports",
+		"jane.doe@example.com": "jane_doe_example_com",
+		"2024 Budget":          "project_2024_budget",
+		"  ":                   "project",
+		"Ventes été":           "ventes_t",
+	}
+	for name, expected := range tests {
+		if actual := getTerraformName(name, "project"); actual != expected {
+			t.Errorf("expected %s for %q, got %s", expected, name, actual)
+		}
+	}
+}
+
+func TestGetUniqueName(t *testing.T) {
+	e := &siteExporter{names: map[string]map[string]bool{}}
+	for _, expected := range []string{"analysts", "analysts_2", "analysts_3"} {
+		if actual := e.getUniqueName("tableau_group", "Analysts", "group"); actual != expected {
+			t.Errorf("expected %s, got %s", expected, actual)
+		}
+	}
+	if actual := e.getUniqueName("tableau_group_user", "Analysts", "group_user"); actual != "analysts" {
+		t.Errorf("expected names to be unique per resource type, got %s", actual)
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	tests := map[string]string{
+		`Sales`:          `"Sales"`,
+		`say "hi"`:       `"say \"hi\""`,
+		`C:\Reports`:     `"C:\\Reports"`,
+		"two\nlines":     `"two\nlines"`,
+		"${var.x} %{if}": `"$${var.x} %%{if}"`,
+	}
+	for value, expected := range tests {
+		if actual := hclString(value); actual != expected {
+			t.Errorf("expected %s for %q, got %s", expected, value, actual)
+		}
+	}
+}
+
+func TestWriteHCLResource(t *testing.T) {
+	b := &strings.Builder{}
+	writeHCLResource(b, "tableau_group", "analysts", "group-id", []hclAttribute{
+		{name: "name", value: `"Analysts"`},
+		{name: "minimum_site_role", value: `"Viewer"`},
+		{name: "site", value: ""},
+	})
+	expected := `import {
+  to = tableau_group.analysts
+  id = "group-id"
+}
+
+resource "tableau_group" "analysts" {
+  name              = "Analysts"
+  minimum_site_role = "Viewer"
+}
+
+`
+	if b.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestGetGranteeCapabilitiesValue(t *testing.T) {
+	e := &siteExporter{
+		userAddresses:  map[string]string{"user-id": "tableau_user.jdoe"},
+		groupAddresses: map[string]string{},
+	}
+	value := e.getGranteeCapabilitiesValue([]GranteeCapability{
+		{
+			User: &User{ID: "user-id"},
+			Capabilities: Capabilities{Capabilities: []Capability{
+				{Name: "Write", Mode: "Allow"},
+				{Name: "Read", Mode: "Allow"},
+			}},
+		},
+		{
+			Group: &Group{ID: "all-users-id"},
+			Capabilities: Capabilities{Capabilities: []Capability{
+				{Name: "Read", Mode: "Deny"},
+			}},
+		},
+	})
+	expected := `[
+    {
+      group_id     = "all-users-id"
+      capabilities = [
+        { name = "Read", mode = "Deny" },
+      ]
+    },
+    {
+      user_id      = tableau_user.jdoe.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+  ]`
+	if value != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, value)
+	}
+}
+
+func TestExportDefaultPermissionsSkipsUnavailableTargetTypes(t *testing.T) {
+	tests := map[string]struct {
+		statusCode  int
+		expectError bool
+	}{
+		"unsupported target type": {statusCode: http.StatusBadRequest, expectError: false},
+		"unknown target type":     {statusCode: http.StatusNotFound, expectError: false},
+		"signed out":              {statusCode: http.StatusUnauthorized, expectError: true},
+		"server error":            {statusCode: http.StatusInternalServerError, expectError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/3.19/sites/site-id/projects/finance-id/default-permissions/lenses" {
+					w.WriteHeader(test.statusCode)
+					return
+				}
+				_, _ = w.Write([]byte(`{"permissions":{}}`))
+			}))
+			defer server.Close()
+
+			e := &siteExporter{
+				client:      &Client{HTTPClient: server.Client(), ApiUrl: server.URL + "/api/3.19/sites/site-id"},
+				projectTree: []ProjectTreeNode{{Project: Project{ID: "finance-id", Name: "Finance"}, Path: "Finance"}},
+				names:       map[string]map[string]bool{},
+			}
+			content, err := e.exportDefaultPermissions()
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected an error, got %q", content)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !strings.Contains(content, "# Skipped lenses default permissions of project Finance") {
+				t.Errorf("expected the lenses default permissions to be skipped, got %q", content)
+			}
+		})
+	}
+}
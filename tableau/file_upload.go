@@ -0,0 +1,81 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// Content files larger than maxSinglePublishSize cannot be published in a single request,
+// they are sent through a file upload session in chunks of fileUploadChunkSize.
+var (
+	maxSinglePublishSize = 64 * 1024 * 1024
+	fileUploadChunkSize  = 32 * 1024 * 1024
+)
+
+type FileUpload struct {
+	UploadSessionID string `json:"uploadSessionId"`
+	FileSize        string `json:"fileSize"`
+}
+
+type FileUploadResponse struct {
+	FileUpload FileUpload `json:"fileUpload"`
+}
+
+// publishContent publishes a datasource or workbook file with its JSON payload, overwriting the content with the same name,
+// and returns the body of the response.
+func (c *Client) publishContent(contentType, fileName string, payload, content []byte) ([]byte, error) {
+	publishURL := fmt.Sprintf("%s/%ss?overwrite=true", c.ApiUrl, contentType)
+	if len(content) <= maxSinglePublishSize {
+		req, err := NewPublishRequest("POST", publishURL, "tableau_"+contentType, fileName, payload, content)
+		if err != nil {
+			return nil, err
+		}
+		return c.doContentRequest(req)
+	}
+
+	uploadSessionID, err := c.UploadFile(content)
+	if err != nil {
+		return nil, err
+	}
+	fileType := strings.TrimPrefix(filepath.Ext(fileName), ".")
+	publishURL = fmt.Sprintf("%s&uploadSessionId=%s&%sType=%s", publishURL, uploadSessionID, contentType, fileType)
+	req, err := NewPublishRequest("POST", publishURL, "", "", payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.doContentRequest(req)
+}
+
+// UploadFile sends content in chunks through a new file upload session and returns the ID of the session.
+func (c *Client) UploadFile(content []byte) (string, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/fileUploads", c.ApiUrl), nil)
+	if err != nil {
+		return "", err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+	fileUploadResponse := FileUploadResponse{}
+	err = json.Unmarshal(body, &fileUploadResponse)
+	if err != nil {
+		return "", err
+	}
+	uploadSessionID := fileUploadResponse.FileUpload.UploadSessionID
+
+	for start := 0; start < len(content); start += fileUploadChunkSize {
+		end := min(start+fileUploadChunkSize, len(content))
+		req, err := NewPublishRequest("PUT", fmt.Sprintf("%s/fileUploads/%s", c.ApiUrl, uploadSessionID), "tableau_file", "file", []byte{}, content[start:end])
+		if err != nil {
+			return "", err
+		}
+		_, err = c.doContentRequest(req)
+		if err != nil {
+			return "", fmt.Errorf("could not upload bytes %d to %d of the file: %w", start, end, err)
+		}
+	}
+	return uploadSessionID, nil
+}
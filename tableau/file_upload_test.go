@@ -0,0 +1,100 @@
+package tableau
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPublishContentThroughFileUpload(t *testing.T) {
+	defer func(maxSize, chunkSize int) {
+		maxSinglePublishSize, fileUploadChunkSize = maxSize, chunkSize
+	}(maxSinglePublishSize, fileUploadChunkSize)
+	maxSinglePublishSize, fileUploadChunkSize = 8, 4
+
+	uploaded := &bytes.Buffer{}
+	chunks := 0
+	var publishQuery string
+	var publishParts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/3.19/sites/site-id/fileUploads":
+			_, _ = w.Write([]byte(`{"fileUpload":{"uploadSessionId":"session-id","fileSize":"0"}}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/3.19/sites/site-id/fileUploads/session-id":
+			chunks++
+			for name, content := range readMultipartParts(t, r) {
+				if name == "tableau_file" {
+					uploaded.Write(content)
+				}
+			}
+			_, _ = w.Write([]byte(`{"fileUpload":{"uploadSessionId":"session-id"}}`))
+		case r.Method == "POST" && r.URL.Path == "/api/3.19/sites/site-id/workbooks":
+			publishQuery = r.URL.RawQuery
+			for name := range readMultipartParts(t, r) {
+				publishParts = append(publishParts, name)
+			}
+			_, _ = w.Write([]byte(`{"workbook":{"id":"workbook-id"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		ApiUrl:     server.URL + "/api/3.19/sites/site-id",
+	}
+	content := []byte("PK0123456789")
+	workbook, err := client.PublishWorkbook("Revenue", "project-id", content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workbook.ID != "workbook-id" {
+		t.Errorf("expected workbook-id, got %s", workbook.ID)
+	}
+	if chunks != 3 {
+		t.Errorf("expected 3 chunks, got %d", chunks)
+	}
+	if !bytes.Equal(uploaded.Bytes(), content) {
+		t.Errorf("expected the chunks to make up %q, got %q", content, uploaded.Bytes())
+	}
+	if publishQuery != "overwrite=true&uploadSessionId=session-id&workbookType=twbx" {
+		t.Errorf("unexpected publish query %s", publishQuery)
+	}
+	if strings.Join(publishParts, ",") != "request_payload" {
+		t.Errorf("expected only the request payload to be published, got %v", publishParts)
+	}
+}
+
+var partNamePattern = regexp.MustCompile(`name="([^"]*)"`)
+
+func readMultipartParts(t *testing.T, r *http.Request) map[string][]byte {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("unexpected content type: %s", err)
+	}
+	parts := map[string][]byte{}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatalf("could not read part: %s", err)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("could not read part: %s", err)
+		}
+		// The publish requests use multipart/mixed, whose parts have no form-data disposition for FormName
+		parts[partNamePattern.FindStringSubmatch(part.Header.Get("Content-Disposition"))[1]] = content
+	}
+}
@@ -0,0 +1,97 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type Flow struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	WebPageURL  string `json:"webpageUrl,omitempty"`
+	Project     struct {
+		ID string `json:"id,omitempty"`
+	} `json:"project,omitempty"`
+	Owner struct {
+		ID string `json:"id,omitempty"`
+	} `json:"owner,omitempty"`
+}
+
+type FlowResponse struct {
+	Flow Flow `json:"flow"`
+}
+
+type FlowsResponse struct {
+	Flows []Flow `json:"flow"`
+}
+
+type FlowListResponse struct {
+	FlowsResponse FlowsResponse     `json:"flows"`
+	Pagination    PaginationDetails `json:"pagination"`
+}
+
+func (c *Client) GetFlow(flowID string) (*Flow, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/flows/%s", c.ApiUrl, flowID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flowResponse := FlowResponse{}
+	err = json.Unmarshal(body, &flowResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &flowResponse.Flow, nil
+}
+
+func (c *Client) GetFlows() ([]Flow, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/flows", c.ApiUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flowListResponse := FlowListResponse{}
+	err = json.Unmarshal(body, &flowListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(flowListResponse.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	allFlows := make([]Flow, 0, totalAvailable)
+	allFlows = append(allFlows, flowListResponse.FlowsResponse.Flows...)
+
+	for page := pageNumber + 1; page <= totalPageCount; page++ {
+		req, err = http.NewRequest("GET", fmt.Sprintf("%s/flows?pageNumber=%d", c.ApiUrl, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err = c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		flowListResponse = FlowListResponse{}
+		err = json.Unmarshal(body, &flowListResponse)
+		if err != nil {
+			return nil, err
+		}
+		allFlows = append(allFlows, flowListResponse.FlowsResponse.Flows...)
+	}
+
+	return allFlows, nil
+}
@@ -0,0 +1,279 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &flowPermissionResource{}
+	_ resource.ResourceWithConfigure        = &flowPermissionResource{}
+	_ resource.ResourceWithImportState      = &flowPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &flowPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &flowPermissionResource{}
+)
+
+func NewFlowPermissionResource() resource.Resource {
+	return &flowPermissionResource{}
+}
+
+type flowPermissionResource struct {
+	client *Client
+}
+
+type flowPermissionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	FlowID         types.String `tfsdk:"flow_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
+	CapabilityName types.String `tfsdk:"capability_name"`
+	CapabilityMode types.String `tfsdk:"capability_mode"`
+}
+
+func (r *flowPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_permission"
+}
+
+func (r *flowPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tableau Flow Permission",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Flow ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
+			"capability_name": schema.StringAttribute{
+				Required:    true,
+				Description: capabilityNameDescription("flow"),
+				Validators: []validator.String{
+					capabilityNameValidator("flow"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"capability_mode": schema.StringAttribute{
+				Required:    true,
+				Description: "Capability mode, Allow or Deny (case sensitive)",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Allow",
+						"Deny",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *flowPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *flowPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validateContentNotLocked(ctx, client, "flow", path.Root("flow_id"), req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
+func (r *flowPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan flowPermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flowID := plan.FlowID.ValueString()
+	granted := GrantedCapability{
+		EntityType:     "users",
+		EntityID:       plan.UserID.ValueString(),
+		CapabilityName: plan.CapabilityName.ValueString(),
+		CapabilityMode: plan.CapabilityMode.ValueString(),
+	}
+	if granted.EntityID == "" {
+		granted.EntityType = "groups"
+		granted.EntityID = plan.GroupID.ValueString()
+	}
+	permissions := Permissions{
+		GranteeCapabilities: GetGranteeCapabilities([]GrantedCapability{granted}),
+	}
+
+	_, err = siteClient.CreatePermissions("flow", flowID, permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating flow permission",
+			"Could not create flow permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(getFlowPermissionID(flowID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), getClientSiteID(r.client, siteClient)))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *flowPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state flowPermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	flowID, permission := getGrantedCapabilityFromID(permissionID)
+	granted, err := siteClient.GetPermission("flow", flowID, permission)
+	if err != nil || granted == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if granted.EntityType == "users" {
+		state.UserID = types.StringValue(granted.EntityID)
+	} else {
+		state.GroupID = types.StringValue(granted.EntityID)
+	}
+	state.ID = types.StringValue(getSiteScopedID(getFlowPermissionID(flowID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.FlowID = types.StringValue(flowID)
+	state.CapabilityName = types.StringValue(granted.CapabilityName)
+	state.CapabilityMode = types.StringValue(granted.CapabilityMode)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *flowPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan flowPermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *flowPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state flowPermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	flowID, permission := getGrantedCapabilityFromID(permissionID)
+	err = siteClient.DeletePermission("flow", flowID, permission.EntityType, permission.EntityID, permission.CapabilityName, permission.CapabilityMode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Flow Permission",
+			"Could not delete permission on flow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *flowPermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *flowPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPermissionState(ctx, r.client, req, resp, "flow", nil, getFlowPermissionID)
+}
+
+func getFlowPermissionID(flowID, entityType, entityID, capabilityName, capabilityMode string) string {
+	return fmt.Sprintf("flows/%s/permissions/%s/%s/%s/%s", flowID, entityType, entityID, capabilityName, capabilityMode)
+}
@@ -0,0 +1,168 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ResolveGranteeName finds the user whose name or email, or the group whose name, matches granteeName.
+// It returns the entity type (users or groups) and ID used by the permission endpoints.
+func (c *Client) ResolveGranteeName(granteeName string) (string, string, error) {
+	users, err := c.GetUsers()
+	if err != nil {
+		return "", "", err
+	}
+	groups, err := c.GetGroups()
+	if err != nil {
+		return "", "", err
+	}
+
+	matches := []string{}
+	entityType, entityID := "", ""
+	for _, user := range users {
+		if strings.EqualFold(user.Name, granteeName) || (user.Email != "" && strings.EqualFold(user.Email, granteeName)) {
+			matches = append(matches, "user "+user.ID)
+			entityType, entityID = "users", user.ID
+		}
+	}
+	for _, group := range groups {
+		if group.Name == granteeName {
+			matches = append(matches, "group "+group.ID)
+			entityType, entityID = "groups", group.ID
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", "", fmt.Errorf("did not find a user or group named %s", granteeName)
+	}
+	if len(matches) > 1 {
+		return "", "", fmt.Errorf("grantee name %s is ambiguous, it matches %s, use user_id or group_id instead", granteeName, strings.Join(matches, ", "))
+	}
+	return entityType, entityID, nil
+}
+
+// granteeConfigValidators makes the single capability permission resources name exactly one grantee.
+func granteeConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("group_id"),
+			path.MatchRoot("grantee_name"),
+		),
+	}
+}
+
+func granteeNameAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Name or email of the user, or name of the group, to grant to - resolved to user_id or group_id at plan time",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// resolvePlannedGrantee fills the user_id and group_id left unknown in the plan of a single capability permission resource.
+// A grantee_name that cannot be resolved yet, for example a user created in the same apply, is resolved again on create.
+func resolvePlannedGrantee(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var granteeName, userID, groupID types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("grantee_name"), &granteeName)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("user_id"), &userID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("group_id"), &groupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The computed grantee IDs are unknown in the plan of any change, they only replace the resource once resolved to another grantee
+	defer keepUnchangedAttributes(ctx, req, resp, path.Root("user_id"), path.Root("group_id"))
+	if (!userID.IsUnknown() && !groupID.IsUnknown()) || granteeName.IsUnknown() {
+		return
+	}
+
+	if !granteeName.IsNull() {
+		if client == nil {
+			return
+		}
+		entityType, entityID, err := client.ResolveGranteeName(granteeName.ValueString())
+		if err != nil {
+			tflog.Debug(ctx, "Could not resolve the grantee name at plan time", map[string]any{"grantee_name": granteeName.ValueString(), "error": err.Error()})
+			return
+		}
+		userID, groupID = getGranteeIDs(entityType, entityID)
+	} else {
+		if userID.IsUnknown() {
+			userID = types.StringNull()
+		}
+		if groupID.IsUnknown() {
+			groupID = types.StringNull()
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+}
+
+// keepUnchangedAttributes removes the string attributes planned to their value in state from the attributes replacing the resource.
+func keepUnchangedAttributes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributePaths ...path.Path) {
+	if req.State.Raw.IsNull() || resp.Plan.Raw.IsNull() {
+		return
+	}
+	requiresReplace := path.Paths{}
+	for _, p := range resp.RequiresReplace {
+		if slices.ContainsFunc(attributePaths, p.Equal) {
+			var planValue, stateValue types.String
+			diags := resp.Plan.GetAttribute(ctx, p, &planValue)
+			diags.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+			if !diags.HasError() && planValue.Equal(stateValue) {
+				continue
+			}
+		}
+		requiresReplace = append(requiresReplace, p)
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+// resolveGrantee resolves a grantee_name that could not be resolved at plan time.
+func resolveGrantee(client *Client, granteeName types.String, userID, groupID *types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !userID.IsUnknown() && !groupID.IsUnknown() {
+		return diags
+	}
+	if granteeName.IsNull() || granteeName.IsUnknown() {
+		*userID = types.StringNull()
+		*groupID = types.StringNull()
+		return diags
+	}
+
+	entityType, entityID, err := client.ResolveGranteeName(granteeName.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("grantee_name"),
+			"Error Resolving Grantee",
+			"Could not resolve grantee name "+granteeName.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+	*userID, *groupID = getGranteeIDs(entityType, entityID)
+	return diags
+}
+
+func getGranteeIDs(entityType, entityID string) (types.String, types.String) {
+	if entityType == "users" {
+		return types.StringValue(entityID), types.StringNull()
+	}
+	return types.StringNull(), types.StringValue(entityID)
+}
@@ -0,0 +1,229 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GrantedCapability is a single capability granted to a user or group, the unit the permission endpoints add and delete.
+type GrantedCapability struct {
+	EntityType     string
+	EntityID       string
+	CapabilityName string
+	CapabilityMode string
+}
+
+func (g GrantedCapability) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", g.EntityType, g.EntityID, g.CapabilityName, g.CapabilityMode)
+}
+
+// GetGrantedCapabilities flattens a permissions document into single granted capabilities.
+func GetGrantedCapabilities(granteeCapabilities []GranteeCapability) []GrantedCapability {
+	granted := []GrantedCapability{}
+	for _, granteeCapability := range granteeCapabilities {
+		entityType := "users"
+		var entityID string
+		if granteeCapability.User != nil {
+			entityID = granteeCapability.User.ID
+		} else if granteeCapability.Group != nil {
+			entityType = "groups"
+			entityID = granteeCapability.Group.ID
+		} else {
+			continue
+		}
+		for _, capability := range granteeCapability.Capabilities.Capabilities {
+			granted = append(granted, GrantedCapability{
+				EntityType:     entityType,
+				EntityID:       entityID,
+				CapabilityName: capability.Name,
+				CapabilityMode: capability.Mode,
+			})
+		}
+	}
+	return granted
+}
+
+// GetGranteeCapabilities groups granted capabilities back into a permissions document, one entry per grantee.
+func GetGranteeCapabilities(granted []GrantedCapability) []GranteeCapability {
+	granteeCapabilities := []GranteeCapability{}
+	index := map[string]int{}
+	for _, g := range granted {
+		key := g.EntityType + "/" + g.EntityID
+		i, ok := index[key]
+		if !ok {
+			granteeCapability := GranteeCapability{}
+			if g.EntityType == "users" {
+				granteeCapability.User = &User{ID: g.EntityID}
+			} else {
+				granteeCapability.Group = &Group{ID: g.EntityID}
+			}
+			granteeCapabilities = append(granteeCapabilities, granteeCapability)
+			i = len(granteeCapabilities) - 1
+			index[key] = i
+		}
+		granteeCapabilities[i].Capabilities.Capabilities = append(granteeCapabilities[i].Capabilities.Capabilities, Capability{
+			Name: g.CapabilityName,
+			Mode: g.CapabilityMode,
+		})
+	}
+	return granteeCapabilities
+}
+
+// DiffGrantedCapabilities returns the capabilities to add and to delete to turn current into desired.
+func DiffGrantedCapabilities(current, desired []GrantedCapability) ([]GrantedCapability, []GrantedCapability) {
+	currentSet := map[GrantedCapability]bool{}
+	for _, g := range current {
+		currentSet[g] = true
+	}
+	desiredSet := map[GrantedCapability]bool{}
+	for _, g := range desired {
+		desiredSet[g] = true
+	}
+
+	toAdd := []GrantedCapability{}
+	for _, g := range desired {
+		if !currentSet[g] {
+			toAdd = append(toAdd, g)
+		}
+	}
+	toDelete := []GrantedCapability{}
+	for _, g := range current {
+		if !desiredSet[g] {
+			toDelete = append(toDelete, g)
+		}
+	}
+	sort.Slice(toDelete, func(i, j int) bool { return toDelete[i].String() < toDelete[j].String() })
+	return toAdd, toDelete
+}
+
+// getGranteeCapabilityModels converts a permissions document into the grantee_capabilities attribute.
+func getGranteeCapabilityModels(granteeCapabilities []GranteeCapability) []GranteeCapabilityModel {
+	models := []GranteeCapabilityModel{}
+	for _, granteeCapability := range granteeCapabilities {
+		model := GranteeCapabilityModel{
+			UserID:  types.StringNull(),
+			GroupID: types.StringNull(),
+		}
+		if granteeCapability.Group != nil {
+			model.GroupID = types.StringValue(granteeCapability.Group.ID)
+		}
+		if granteeCapability.User != nil {
+			model.UserID = types.StringValue(granteeCapability.User.ID)
+		}
+		model.Capabilities = []CapabilityModel{}
+		for _, capability := range granteeCapability.Capabilities.Capabilities {
+			model.Capabilities = append(model.Capabilities, CapabilityModel{
+				Name: types.StringValue(capability.Name),
+				Mode: types.StringValue(capability.Mode),
+			})
+		}
+		models = append(models, model)
+	}
+	return models
+}
+
+// getGrantedCapabilitiesFromModels flattens the grantee_capabilities attribute, each entry must name exactly one of user_id or group_id.
+func getGrantedCapabilitiesFromModels(models []GranteeCapabilityModel) ([]GrantedCapability, error) {
+	granted := []GrantedCapability{}
+	for _, model := range models {
+		userID := model.UserID.ValueString()
+		groupID := model.GroupID.ValueString()
+		if (userID == "") == (groupID == "") {
+			return nil, fmt.Errorf("each grantee must set exactly one of user_id or group_id")
+		}
+		entityType := "users"
+		entityID := userID
+		if groupID != "" {
+			entityType = "groups"
+			entityID = groupID
+		}
+		for _, capability := range model.Capabilities {
+			granted = append(granted, GrantedCapability{
+				EntityType:     entityType,
+				EntityID:       entityID,
+				CapabilityName: capability.Name.ValueString(),
+				CapabilityMode: capability.Mode.ValueString(),
+			})
+		}
+	}
+	return granted, nil
+}
+
+// ReconcileGrantedCapabilities deletes the current grants that are not desired, then adds the missing ones in a single call.
+// Deleting first also covers capabilities that switch between Allow and Deny.
+func ReconcileGrantedCapabilities(current, desired []GrantedCapability, add func([]GranteeCapability) error, remove func(GrantedCapability) error) error {
+	toAdd, toDelete := DiffGrantedCapabilities(current, desired)
+	for _, g := range toDelete {
+		err := remove(g)
+		if err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		return add(GetGranteeCapabilities(toAdd))
+	}
+	return nil
+}
+
+// getKnownGrantedCapabilities flattens a grantee_capabilities set from a config or plan, skipping values that are still unknown
+// and reporting grantees without exactly one of user_id or group_id.
+func getKnownGrantedCapabilities(ctx context.Context, granteeCapabilities types.Set) ([]GrantedCapability, diag.Diagnostics) {
+	granted := []GrantedCapability{}
+	if granteeCapabilities.IsUnknown() || granteeCapabilities.IsNull() {
+		return granted, nil
+	}
+
+	var grantees []struct {
+		UserID       types.String `tfsdk:"user_id"`
+		GroupID      types.String `tfsdk:"group_id"`
+		Capabilities types.Set    `tfsdk:"capabilities"`
+	}
+	diags := granteeCapabilities.ElementsAs(ctx, &grantees, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, grantee := range grantees {
+		if grantee.UserID.IsUnknown() || grantee.GroupID.IsUnknown() || grantee.Capabilities.IsUnknown() || grantee.Capabilities.IsNull() {
+			continue
+		}
+		userID := grantee.UserID.ValueString()
+		groupID := grantee.GroupID.ValueString()
+		if (userID == "") == (groupID == "") {
+			diags.AddAttributeError(
+				path.Root("grantee_capabilities"),
+				"Invalid Grantee",
+				"Each grantee must set exactly one of user_id or group_id",
+			)
+			continue
+		}
+		entityType := "users"
+		entityID := userID
+		if groupID != "" {
+			entityType = "groups"
+			entityID = groupID
+		}
+
+		var capabilities []CapabilityModel
+		diags.Append(grantee.Capabilities.ElementsAs(ctx, &capabilities, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for _, capability := range capabilities {
+			if capability.Name.IsUnknown() || capability.Mode.IsUnknown() {
+				continue
+			}
+			granted = append(granted, GrantedCapability{
+				EntityType:     entityType,
+				EntityID:       entityID,
+				CapabilityName: capability.Name.ValueString(),
+				CapabilityMode: capability.Mode.ValueString(),
+			})
+		}
+	}
+	return granted, diags
+}
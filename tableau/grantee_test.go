@@ -0,0 +1,145 @@
+package tableau
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newGranteesTestClient returns a client signed in to the finance site of a server with the user alice and the group analysts.
+func newGranteesTestClient(t *testing.T) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/3.19/sites":
+			_, _ = w.Write([]byte(`{"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"1"},"sites":{"site":[{"id":"finance-id","contentUrl":"finance"}]}}`))
+		case "/api/3.19/sites/finance-id/users":
+			_, _ = w.Write([]byte(`{"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"1"},"users":{"user":[{"id":"alice-id","name":"alice"}]}}`))
+		case "/api/3.19/sites/finance-id/groups":
+			_, _ = w.Write([]byte(`{"pagination":{"pageNumber":"1","pageSize":"100","totalAvailable":"1"},"groups":{"group":[{"id":"analysts-id","name":"analysts"}]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{
+		HTTPClient:     server.Client(),
+		BaseUrl:        server.URL + "/api/3.19",
+		ApiUrl:         server.URL + "/api/3.19/sites/finance-id",
+		SiteID:         "finance-id",
+		SiteContentURL: "finance",
+		SiteAliases:    map[string]string{"fin": "finance"},
+	}
+}
+
+// newFlowPermissionPlan returns the plan of a flow permission moving from the state values to the planned values.
+// The unknown attributes are unknown in the plan and replace the resource, as the framework plans computed attributes on any change.
+func newFlowPermissionPlan(t *testing.T, state, planned map[string]string, unknown ...string) (resource.ModifyPlanRequest, *resource.ModifyPlanResponse) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&flowPermissionResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	newValue := func(values map[string]string, unknown []string) tftypes.Value {
+		attributes := map[string]tftypes.Value{}
+		for name := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(tftypes.String, nil)
+			if value, ok := values[name]; ok {
+				attributes[name] = tftypes.NewValue(tftypes.String, value)
+			}
+		}
+		for _, name := range unknown {
+			attributes[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		}
+		return tftypes.NewValue(objectType, attributes)
+	}
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: newValue(state, nil)},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(planned, unknown)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	for _, name := range unknown {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+	}
+	return req, resp
+}
+
+func TestResolvePlannedGranteeKeepsUnchangedGrantee(t *testing.T) {
+	client := newGranteesTestClient(t)
+	state := map[string]string{
+		"id":              "flows/flow-id/permissions/users/alice-id/Read/Allow",
+		"flow_id":         "flow-id",
+		"user_id":         "alice-id",
+		"grantee_name":    "alice",
+		"capability_name": "Read",
+		"capability_mode": "Allow",
+	}
+
+	tests := map[string]struct {
+		granteeName     string
+		requiresReplace bool
+	}{
+		"same grantee":  {granteeName: "alice", requiresReplace: false},
+		"other grantee": {granteeName: "analysts", requiresReplace: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planned := map[string]string{}
+			for k, v := range state {
+				planned[k] = v
+			}
+			planned["grantee_name"] = test.granteeName
+			req, resp := newFlowPermissionPlan(t, state, planned, "user_id", "group_id")
+
+			resolvePlannedGrantee(context.Background(), client, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if actual := len(resp.RequiresReplace) > 0; actual != test.requiresReplace {
+				t.Errorf("expected requires replace %t, got %v", test.requiresReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestFlowPermissionModifyPlanKeepsGranteeOnEquivalentSite(t *testing.T) {
+	client := newGranteesTestClient(t)
+	state := map[string]string{
+		"id":              "flows/flow-id/permissions/users/alice-id/Read/Allow",
+		"flow_id":         "flow-id",
+		"user_id":         "alice-id",
+		"grantee_name":    "alice",
+		"site":            "finance-id",
+		"capability_name": "Read",
+		"capability_mode": "Allow",
+	}
+	planned := map[string]string{}
+	for k, v := range state {
+		planned[k] = v
+	}
+	planned["site"] = "fin"
+	req, resp := newFlowPermissionPlan(t, state, planned, "user_id", "group_id")
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("site"))
+
+	ctx := context.Background()
+	(&flowPermissionResource{client: client}).ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(resp.RequiresReplace) > 0 {
+		t.Errorf("expected the permission to be kept, got requires replace %v", resp.RequiresReplace)
+	}
+	var plan flowPermissionResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if plan.UserID.ValueString() != "alice-id" || !plan.GroupID.IsNull() {
+		t.Errorf("expected the grantee to be resolved to user alice-id, got user %s and group %s", plan.UserID, plan.GroupID)
+	}
+}
@@ -22,6 +22,7 @@ type groupDataSource struct {
 }
 
 type groupDataSourceModel struct {
+	Site            types.String `tfsdk:"site"`
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
 	MinimumSiteRole types.String `tfsdk:"minimum_site_role"`
@@ -35,6 +36,7 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Retrieve group details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the group",
@@ -56,7 +58,16 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	group, err := d.client.GetGroup(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	group, err := siteClient.GetGroup(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Group",
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +17,7 @@ var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupResource{}
 )
 
 func NewGroupResource() resource.Resource {
@@ -30,6 +30,7 @@ type groupResource struct {
 
 type groupResourceModel struct {
 	ID              types.String `tfsdk:"id"`
+	Site            types.String `tfsdk:"site"`
 	Name            types.String `tfsdk:"name"`
 	MinimumSiteRole types.String `tfsdk:"minimum_site_role"`
 	LastUpdated     types.String `tfsdk:"last_updated"`
@@ -49,6 +50,7 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": siteAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Display name for group",
@@ -78,6 +80,10 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan groupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -86,6 +92,15 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	group := Group{
 		Name: plan.Name.ValueString(),
 	}
@@ -93,7 +108,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		group.MinimumSiteRole = plan.MinimumSiteRole.ValueString()
 	}
 
-	createdGroup, err := r.client.CreateGroup(group.Name, group.MinimumSiteRole)
+	createdGroup, err := siteClient.CreateGroup(group.Name, group.MinimumSiteRole)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
@@ -120,7 +135,16 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	group, err := r.client.GetGroup(state.ID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	group, err := siteClient.GetGroup(state.ID.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -150,12 +174,21 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	group := Group{
 		Name:            plan.Name.ValueString(),
 		MinimumSiteRole: plan.MinimumSiteRole.ValueString(),
 	}
 
-	_, err := r.client.UpdateGroup(plan.ID.ValueString(), group.Name, group.MinimumSiteRole)
+	_, err = siteClient.UpdateGroup(plan.ID.ValueString(), group.Name, group.MinimumSiteRole)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Group",
@@ -164,7 +197,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updatedGroup, err := r.client.GetGroup(plan.ID.ValueString())
+	updatedGroup, err := siteClient.GetGroup(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Group",
@@ -192,7 +225,16 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteGroup(state.ID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = siteClient.DeleteGroup(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Group",
@@ -211,5 +253,5 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importSiteContentState(ctx, r.client, req, resp, "groupID or name", (*Client).ResolveGroupImportID)
 }
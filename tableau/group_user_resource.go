@@ -2,6 +2,8 @@ package tableau
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -10,12 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &groupUserResource{}
-	_ resource.ResourceWithConfigure = &groupUserResource{}
+	_ resource.Resource               = &groupUserResource{}
+	_ resource.ResourceWithConfigure  = &groupUserResource{}
+	_ resource.ResourceWithModifyPlan = &groupUserResource{}
 )
 
 func NewGroupUserResource() resource.Resource {
@@ -28,6 +30,7 @@ type groupUserResource struct {
 
 type groupUserResourceModel struct {
 	ID          types.String `tfsdk:"id"`
+	Site        types.String `tfsdk:"site"`
 	GroupID     types.String `tfsdk:"group_id"`
 	UserID      types.String `tfsdk:"user_id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
@@ -47,13 +50,20 @@ func (r *groupUserResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": siteAttribute(),
 			"group_id": schema.StringAttribute{
 				Required:    true,
 				Description: "Group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"user_id": schema.StringAttribute{
 				Required:    true,
 				Description: "User identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -62,6 +72,10 @@ func (r *groupUserResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *groupUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *groupUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan groupUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -70,11 +84,20 @@ func (r *groupUserResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	groupUser := User{
 		ID: plan.UserID.ValueString(),
 	}
 
-	_, err := r.client.CreateGroupUser(plan.GroupID.ValueString(), groupUser.ID)
+	_, err = siteClient.CreateGroupUser(plan.GroupID.ValueString(), groupUser.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating groupUser",
@@ -102,13 +125,22 @@ func (r *groupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	groupID := state.GroupID.ValueString()
 	userID := state.UserID.ValueString()
 	if (groupID == "") || (userID == "") {
 		groupID, userID = GetIDsFromCombinedID(state.ID.ValueString())
 	}
 
-	groupUser, err := r.client.GetGroupUser(groupID, userID)
+	groupUser, err := siteClient.GetGroupUser(groupID, userID)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -127,7 +159,21 @@ func (r *groupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *groupUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	tflog.Info(ctx, "Group Users do not support updates")
+	var plan groupUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group and the user replace the resource, only the site can be renamed to an equivalent one
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *groupUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -138,7 +184,16 @@ func (r *groupUserResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteGroupUser(state.GroupID.ValueString(), state.UserID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = siteClient.DeleteGroupUser(state.GroupID.ValueString(), state.UserID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Group User",
@@ -157,5 +212,49 @@ func (r *groupUserResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 func (r *groupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: "group:user" or "group:user:site", where group is a group ID or name and user a user ID, name or email
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'group:user' or 'group:user:site', got '"+req.ID+"'",
+		)
+		return
+	}
+	site := ""
+	if len(parts) == 3 {
+		site = parts[2]
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(site)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	groupID, err := siteClient.ResolveGroupImportID(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not resolve group '"+parts[0]+"': "+err.Error(),
+		)
+		return
+	}
+	userID, err := siteClient.ResolveUserImportID(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not resolve user '"+parts[1]+"': "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), GetCombinedID(groupID, userID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	if site != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	}
 }
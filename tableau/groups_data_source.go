@@ -28,6 +28,7 @@ type groupsNestedDataModel struct {
 }
 
 type groupsDataSourceModel struct {
+	Site   types.String            `tfsdk:"site"`
 	ID     types.String            `tfsdk:"id"`
 	Groups []groupsNestedDataModel `tfsdk:"groups"`
 }
@@ -40,6 +41,7 @@ func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Retrieve groups details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the list of groups",
@@ -73,7 +75,16 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	groups, err := d.client.GetGroups()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	groups, err := siteClient.GetGroups()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Groups",
@@ -0,0 +1,251 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResolveUserImportID returns the ID of the user with the given ID, name or email.
+func (c *Client) ResolveUserImportID(identifier string) (string, error) {
+	users, err := c.GetUsers()
+	if err != nil {
+		return "", err
+	}
+	matches := []string{}
+	for _, user := range users {
+		if user.ID == identifier {
+			return user.ID, nil
+		}
+		if strings.EqualFold(user.Name, identifier) || (user.Email != "" && strings.EqualFold(user.Email, identifier)) {
+			matches = append(matches, user.ID)
+		}
+	}
+	return getSingleImportMatch("user", identifier, matches)
+}
+
+// ResolveGroupImportID returns the ID of the group with the given ID or name.
+func (c *Client) ResolveGroupImportID(identifier string) (string, error) {
+	groups, err := c.GetGroups()
+	if err != nil {
+		return "", err
+	}
+	matches := []string{}
+	for _, group := range groups {
+		if group.ID == identifier {
+			return group.ID, nil
+		}
+		if group.Name == identifier {
+			matches = append(matches, group.ID)
+		}
+	}
+	return getSingleImportMatch("group", identifier, matches)
+}
+
+// ResolveProjectImportID returns the ID of the project with the given ID or path, for example Finance/EMEA/Reports.
+func (c *Client) ResolveProjectImportID(identifier string) (string, error) {
+	nodes, err := c.GetProjectTree()
+	if err != nil {
+		return "", err
+	}
+	for _, node := range nodes {
+		if node.Project.ID == identifier {
+			return node.Project.ID, nil
+		}
+	}
+	node, err := findProjectTreeNodeByPath(nodes, identifier)
+	if err != nil {
+		return "", fmt.Errorf("%s is not a project ID: %w", identifier, err)
+	}
+	return node.Project.ID, nil
+}
+
+// ResolveWorkbookImportID returns the ID of the workbook with the given ID, or the path of its project followed by its name, for example Finance/EMEA/Revenue.
+func (c *Client) ResolveWorkbookImportID(identifier string) (string, error) {
+	workbooks, err := c.GetWorkbooks()
+	if err != nil {
+		return "", err
+	}
+	for _, workbook := range workbooks {
+		if workbook.ID == identifier {
+			return workbook.ID, nil
+		}
+	}
+	projectID, name, err := c.resolveContentPath(identifier)
+	if err != nil {
+		return "", err
+	}
+	matches := []string{}
+	for _, workbook := range workbooks {
+		if workbook.Project.ID == projectID && workbook.Name == name {
+			matches = append(matches, workbook.ID)
+		}
+	}
+	return getSingleImportMatch("workbook", identifier, matches)
+}
+
+// ResolveDatasourceImportID returns the ID of the data source with the given ID, or the path of its project followed by its name.
+func (c *Client) ResolveDatasourceImportID(identifier string) (string, error) {
+	datasources, err := c.GetDatasources()
+	if err != nil {
+		return "", err
+	}
+	for _, datasource := range datasources {
+		if datasource.ID == identifier {
+			return datasource.ID, nil
+		}
+	}
+	projectID, name, err := c.resolveContentPath(identifier)
+	if err != nil {
+		return "", err
+	}
+	matches := []string{}
+	for _, datasource := range datasources {
+		if datasource.Project.ID == projectID && datasource.Name == name {
+			matches = append(matches, datasource.ID)
+		}
+	}
+	return getSingleImportMatch("data source", identifier, matches)
+}
+
+// resolveContentPath splits a content path into the ID of its project and the name of the content item.
+func (c *Client) resolveContentPath(contentPath string) (string, string, error) {
+	contentPath = strings.Trim(contentPath, "/")
+	separator := strings.LastIndex(contentPath, "/")
+	if separator == -1 {
+		return "", "", fmt.Errorf("%s is neither an ID nor a path made of a project path and a name, for example Finance/EMEA/Revenue", contentPath)
+	}
+	projectID, err := c.ResolveProjectImportID(contentPath[:separator])
+	if err != nil {
+		return "", "", err
+	}
+	return projectID, contentPath[separator+1:], nil
+}
+
+func getSingleImportMatch(contentType, identifier string, matches []string) (string, error) {
+	if len(matches) == 0 {
+		return "", fmt.Errorf("did not find a %s matching %s", contentType, identifier)
+	}
+	if len(matches) > 1 {
+		return "", fmt.Errorf("%s %s is ambiguous, it matches IDs %s, import by ID instead", contentType, identifier, strings.Join(matches, ", "))
+	}
+	return matches[0], nil
+}
+
+// importSiteContentState imports a resource of a site from "identifier[:site]", where resolve turns the identifier into the resource ID.
+// The ID is also set on the extra attributes, for example workbook_id.
+func importSiteContentState(ctx context.Context, client *Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, format string, resolve func(*Client, string) (string, error), attributes ...string) {
+	identifier, site, _ := strings.Cut(req.ID, ":")
+	if identifier == "" || strings.Contains(site, ":") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format '"+format+"' or '"+format+":site', got '"+req.ID+"'",
+		)
+		return
+	}
+	siteClient, err := client.ResolveSiteClient(site)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	id, err := resolve(siteClient, identifier)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not resolve import ID '"+req.ID+"': "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	for _, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), id)...)
+	}
+	if site != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	}
+}
+
+// importPermissionState imports a single capability permission resource from its ID, or from "content:grantee:capability[:mode[:site]]".
+// The content is resolved with resolveContent when set and taken as an ID otherwise, the grantee is a user name or email or a group name
+// and the mode defaults to Allow.
+func importPermissionState(ctx context.Context, client *Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, contentType string,
+	resolveContent func(*Client, string) (string, error), getPermissionID func(contentID, entityType, entityID, capabilityName, capabilityMode string) string) {
+	if strings.Contains(req.ID, "/permissions/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 3 || len(parts) > 5 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be the resource ID or in format 'content:grantee:capability', 'content:grantee:capability:mode' or 'content:grantee:capability:mode:site', got '"+req.ID+"'",
+		)
+		return
+	}
+	capabilityName := parts[2]
+	if capabilities, ok := contentTypeCapabilities[contentType]; ok && !slices.Contains(capabilities, capabilityName) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Capability "+capabilityName+" does not apply to "+contentType+", use one of "+strings.Join(capabilities, "/"),
+		)
+		return
+	}
+	capabilityMode := "Allow"
+	if len(parts) > 3 {
+		capabilityMode = parts[3]
+	}
+	if capabilityMode != "Allow" && capabilityMode != "Deny" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Capability mode must be Allow or Deny (case sensitive), got "+capabilityMode,
+		)
+		return
+	}
+	site := ""
+	if len(parts) > 4 {
+		site = parts[4]
+	}
+
+	siteClient, err := client.ResolveSiteClient(site)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	contentID := parts[0]
+	if resolveContent != nil {
+		contentID, err = resolveContent(siteClient, parts[0])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Could not resolve content '"+parts[0]+"': "+err.Error(),
+			)
+			return
+		}
+	}
+	entityType, entityID, err := siteClient.ResolveGranteeName(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not resolve grantee '"+parts[1]+"': "+err.Error(),
+		)
+		return
+	}
+
+	id := getSiteScopedID(getPermissionID(contentID, entityType, entityID, capabilityName, capabilityMode), getClientSiteID(client, siteClient))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if site != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	}
+}
@@ -0,0 +1,42 @@
+package tableau
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestImportPermissionStateInvalidIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "missing capability", id: "Finance:Analysts"},
+		{name: "empty grantee", id: "Finance::Read"},
+		{name: "too many parts", id: "Finance:Analysts:Read:Allow:site:extra"},
+		{name: "unknown capability", id: "Finance:Analysts:ExportData"},
+		{name: "lowercase mode", id: "Finance:Analysts:Read:allow"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{}
+			importPermissionState(context.Background(), nil, resource.ImportStateRequest{ID: test.id}, resp, "project", (*Client).ResolveProjectImportID, getProjectPermissionID)
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error for import ID %s", test.id)
+			}
+		})
+	}
+}
+
+func TestGetSingleImportMatch(t *testing.T) {
+	if id, err := getSingleImportMatch("group", "Analysts", []string{"a"}); err != nil || id != "a" {
+		t.Errorf("expected a, got %s, %v", id, err)
+	}
+	if _, err := getSingleImportMatch("group", "Analysts", nil); err == nil {
+		t.Error("expected an error without match")
+	}
+	if _, err := getSingleImportMatch("group", "Analysts", []string{"a", "b"}); err == nil {
+		t.Error("expected an error with several matches")
+	}
+}
@@ -0,0 +1,80 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetContentProject returns the project containing a content item, for the content types that live in projects.
+func (c *Client) GetContentProject(contentType, contentID string) (*Project, error) {
+	var projectID string
+	switch contentType {
+	case "workbook":
+		workbook, err := c.GetWorkbook(contentID)
+		if err != nil {
+			return nil, err
+		}
+		projectID = workbook.Project.ID
+	case "datasource":
+		datasource, err := c.GetDatasource(contentID, "")
+		if err != nil {
+			return nil, err
+		}
+		projectID = datasource.Project.ID
+	case "view":
+		view, err := c.GetView(contentID)
+		if err != nil {
+			return nil, err
+		}
+		projectID = view.Project.ID
+	case "virtual_connection":
+		virtualConnection, err := c.GetVirtualConnection(contentID)
+		if err != nil {
+			return nil, err
+		}
+		projectID = virtualConnection.Project.ID
+	case "flow":
+		flow, err := c.GetFlow(contentID)
+		if err != nil {
+			return nil, err
+		}
+		projectID = flow.Project.ID
+	default:
+		return nil, fmt.Errorf("content type %s is not placed in projects", contentType)
+	}
+	return c.GetProject(projectID)
+}
+
+// validateContentNotLocked fails the plan of a direct grant on a content item whose project locks the permissions of its content.
+// Only new or replaced grants are checked, so existing grants keep planning after the project gets locked.
+func validateContentNotLocked(ctx context.Context, client *Client, contentType string, contentIDPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var contentID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, contentIDPath, &contentID)...)
+	if resp.Diagnostics.HasError() || contentID.IsUnknown() || contentID.IsNull() {
+		return
+	}
+
+	project, err := client.GetContentProject(contentType, contentID.ValueString())
+	if err != nil {
+		// The content may not be readable yet, apply reports the actual error
+		tflog.Debug(ctx, "Could not look up the project of the content", map[string]any{"content_type": contentType, "content_id": contentID.ValueString(), "error": err.Error()})
+		return
+	}
+	if project.ContentPermissions == "LockedToProject" || project.ContentPermissions == "LockedToProjectWithoutNested" {
+		resp.Diagnostics.AddAttributeError(
+			contentIDPath,
+			"Permissions Locked to Project",
+			fmt.Sprintf("The %s %s is in project %q (%s) whose content permissions are %s, so Tableau does not allow permissions to be set on it directly. "+
+				"Manage the project default permissions with tableau_project_default_permissions instead, or set content_permissions of the project to ManagedByOwner.",
+				contentType, contentID.ValueString(), project.Name, project.ID, project.ContentPermissions),
+		)
+	}
+}
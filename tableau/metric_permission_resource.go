@@ -0,0 +1,277 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &metricPermissionResource{}
+	_ resource.ResourceWithConfigure        = &metricPermissionResource{}
+	_ resource.ResourceWithImportState      = &metricPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &metricPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &metricPermissionResource{}
+)
+
+func NewMetricPermissionResource() resource.Resource {
+	return &metricPermissionResource{}
+}
+
+type metricPermissionResource struct {
+	client *Client
+}
+
+type metricPermissionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	MetricID       types.String `tfsdk:"metric_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
+	CapabilityName types.String `tfsdk:"capability_name"`
+	CapabilityMode types.String `tfsdk:"capability_mode"`
+}
+
+func (r *metricPermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_permission"
+}
+
+func (r *metricPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tableau Metric Permission",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metric_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Metric ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
+			"capability_name": schema.StringAttribute{
+				Required:    true,
+				Description: capabilityNameDescription("metric"),
+				Validators: []validator.String{
+					capabilityNameValidator("metric"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"capability_mode": schema.StringAttribute{
+				Required:    true,
+				Description: "Capability mode, Allow or Deny (case sensitive)",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Allow",
+						"Deny",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *metricPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *metricPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
+func (r *metricPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan metricPermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metricID := plan.MetricID.ValueString()
+	granted := GrantedCapability{
+		EntityType:     "users",
+		EntityID:       plan.UserID.ValueString(),
+		CapabilityName: plan.CapabilityName.ValueString(),
+		CapabilityMode: plan.CapabilityMode.ValueString(),
+	}
+	if granted.EntityID == "" {
+		granted.EntityType = "groups"
+		granted.EntityID = plan.GroupID.ValueString()
+	}
+	permissions := Permissions{
+		GranteeCapabilities: GetGranteeCapabilities([]GrantedCapability{granted}),
+	}
+
+	_, err = siteClient.CreatePermissions("metric", metricID, permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating metric permission",
+			"Could not create metric permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(getMetricPermissionID(metricID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), getClientSiteID(r.client, siteClient)))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state metricPermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	metricID, permission := getGrantedCapabilityFromID(permissionID)
+	granted, err := siteClient.GetPermission("metric", metricID, permission)
+	if err != nil || granted == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if granted.EntityType == "users" {
+		state.UserID = types.StringValue(granted.EntityID)
+	} else {
+		state.GroupID = types.StringValue(granted.EntityID)
+	}
+	state.ID = types.StringValue(getSiteScopedID(getMetricPermissionID(metricID, granted.EntityType, granted.EntityID, granted.CapabilityName, granted.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.MetricID = types.StringValue(metricID)
+	state.CapabilityName = types.StringValue(granted.CapabilityName)
+	state.CapabilityMode = types.StringValue(granted.CapabilityMode)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan metricPermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *metricPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state metricPermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	metricID, permission := getGrantedCapabilityFromID(permissionID)
+	err = siteClient.DeletePermission("metric", metricID, permission.EntityType, permission.EntityID, permission.CapabilityName, permission.CapabilityMode)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Metric Permission",
+			"Could not delete permission on metric, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *metricPermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *metricPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importPermissionState(ctx, r.client, req, resp, "metric", nil, getMetricPermissionID)
+}
+
+func getMetricPermissionID(metricID, entityType, entityID, capabilityName, capabilityMode string) string {
+	return fmt.Sprintf("metrics/%s/permissions/%s/%s/%s/%s", metricID, entityType, entityID, capabilityName, capabilityMode)
+}
@@ -0,0 +1,155 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// permissionContentTypes maps the content types accepted by tableau_permissions to their REST API path.
+var permissionContentTypes = map[string]string{
+	"database":           "databases",
+	"datarole":           "dataroles",
+	"datasource":         "datasources",
+	"flow":               "flows",
+	"lens":               "lenses",
+	"metric":             "metrics",
+	"project":            "projects",
+	"table":              "tables",
+	"view":               "views",
+	"virtual_connection": "virtualconnections",
+	"workbook":           "workbooks",
+}
+
+func getPermissionContentTypes() []string {
+	contentTypes := []string{}
+	for contentType := range permissionContentTypes {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	return contentTypes
+}
+
+type Permissions struct {
+	GranteeCapabilities []GranteeCapability `json:"granteeCapabilities"`
+}
+
+type PermissionsRequest struct {
+	Permissions Permissions `json:"permissions"`
+}
+
+type PermissionsResponse struct {
+	Permissions Permissions `json:"permissions"`
+}
+
+func getPermissionsURL(apiURL, contentType, contentID string) (string, error) {
+	contentPath, ok := permissionContentTypes[contentType]
+	if !ok {
+		return "", fmt.Errorf("unknown content type (%s) not in: %s", contentType, strings.Join(getPermissionContentTypes(), ", "))
+	}
+	return fmt.Sprintf("%s/%s/%s/permissions", apiURL, contentPath, contentID), nil
+}
+
+func (c *Client) GetPermissions(contentType, contentID string) (*Permissions, error) {
+	permissionsURL, err := getPermissionsURL(c.ApiUrl, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", permissionsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	permissionsResponse := PermissionsResponse{}
+	err = json.Unmarshal(body, &permissionsResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionsResponse.Permissions, nil
+}
+
+// GetPermission returns the capability granted on the content, or nil when it is no longer granted.
+func (c *Client) GetPermission(contentType, contentID string, granted GrantedCapability) (*GrantedCapability, error) {
+	permissions, err := c.GetPermissions(contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range GetGrantedCapabilities(permissions.GranteeCapabilities) {
+		if g == granted {
+			return &g, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) CreatePermissions(contentType, contentID string, permissions Permissions) (*Permissions, error) {
+	permissionsURL, err := getPermissionsURL(c.ApiUrl, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionsRequest := PermissionsRequest{
+		Permissions: permissions,
+	}
+
+	newPermissionsJson, err := json.Marshal(permissionsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", permissionsURL, strings.NewReader(string(newPermissionsJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionsResponse := PermissionsResponse{}
+	err = json.Unmarshal(body, &permissionsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permissionsResponse.Permissions, nil
+}
+
+func (c *Client) DeletePermission(contentType, contentID, entityType, entityID, capabilityName, capabilityMode string) error {
+	permissionsURL, err := getPermissionsURL(c.ApiUrl, contentType, contentID)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/%s/%s/%s/%s", permissionsURL, entityType, entityID, capabilityName, capabilityMode), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getGrantedCapabilityFromID splits the "<contents>/<content ID>/permissions/<entity type>/<entity ID>/<capability>/<mode>" ID
+// of a single capability permission resource into the content ID and the granted capability.
+func getGrantedCapabilityFromID(permissionID string) (string, GrantedCapability) {
+	parts := strings.Split(permissionID, "/")
+	return parts[1], GrantedCapability{
+		EntityType:     parts[3],
+		EntityID:       parts[4],
+		CapabilityName: parts[5],
+		CapabilityMode: parts[6],
+	}
+}
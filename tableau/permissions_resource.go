@@ -0,0 +1,344 @@
+package tableau
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &permissionsResource{}
+	_ resource.ResourceWithConfigure      = &permissionsResource{}
+	_ resource.ResourceWithImportState    = &permissionsResource{}
+	_ resource.ResourceWithModifyPlan     = &permissionsResource{}
+	_ resource.ResourceWithValidateConfig = &permissionsResource{}
+)
+
+func NewPermissionsResource() resource.Resource {
+	return &permissionsResource{}
+}
+
+type permissionsResource struct {
+	client *Client
+}
+
+type permissionsResourceModel struct {
+	ID                  types.String             `tfsdk:"id"`
+	ContentType         types.String             `tfsdk:"content_type"`
+	ContentID           types.String             `tfsdk:"content_id"`
+	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+	Site                types.String             `tfsdk:"site"`
+	LastUpdated         types.String             `tfsdk:"last_updated"`
+}
+
+func (r *permissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (r *permissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritative permissions of a content item, owning its whole permissions document. Grants that are not declared are removed and grants added outside of Terraform are reported as drift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the content item",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the content item, one of " + strings.Join(getPermissionContentTypes(), "/"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(getPermissionContentTypes()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_capabilities": granteeCapabilitiesResourceAttribute(),
+			"site":                 siteAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the permissions",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var contentType types.String
+	var granteeCapabilities types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_type"), &contentType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("grantee_capabilities"), &granteeCapabilities)...)
+	if resp.Diagnostics.HasError() || contentType.IsUnknown() || contentType.IsNull() || granteeCapabilities.IsUnknown() || granteeCapabilities.IsNull() {
+		return
+	}
+	capabilities, ok := contentTypeCapabilities[contentType.ValueString()]
+	if !ok {
+		return
+	}
+
+	granted, diags := getKnownGrantedCapabilities(ctx, granteeCapabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, g := range granted {
+		if slices.Contains(capabilities, g.CapabilityName) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grantee_capabilities"),
+			"Invalid capability",
+			"Capability "+g.CapabilityName+" does not apply to "+contentType.ValueString()+", use one of "+strings.Join(capabilities, "/"),
+		)
+	}
+}
+
+func (r *permissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var contentType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content_type"), &contentType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := getPlanSiteClient(ctx, r.client, req)
+	switch contentType.ValueString() {
+	case "datasource", "flow", "view", "virtual_connection", "workbook":
+		validateContentNotLocked(ctx, client, contentType.ValueString(), path.Root("content_id"), req, resp)
+	}
+	validatePermissionsSiteRoles(ctx, client, req, resp)
+}
+
+func (r *permissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan permissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentType := plan.ContentType.ValueString()
+	contentID := plan.ContentID.ValueString()
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = reconcilePermissions(siteClient, contentType, contentID, plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating permissions",
+			"Could not create "+contentType+" permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(GetCombinedID(contentType, contentID), getClientSiteID(r.client, siteClient)))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *permissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state permissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsID, siteID := splitSiteScopedID(state.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	contentType, contentID := GetIDsFromCombinedID(permissionsID)
+	perms, err := siteClient.GetPermissions(contentType, contentID)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ContentType = types.StringValue(contentType)
+	state.ContentID = types.StringValue(contentID)
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.GranteeCapabilities = getGranteeCapabilityModels(perms.GranteeCapabilities)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *permissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan permissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, siteID := splitSiteScopedID(plan.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = reconcilePermissions(siteClient, plan.ContentType.ValueString(), plan.ContentID.ValueString(), plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Permissions",
+			"Could not update "+plan.ContentType.ValueString()+" permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *permissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state permissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsID, siteID := splitSiteScopedID(state.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	contentType, contentID := GetIDsFromCombinedID(permissionsID)
+	err = reconcilePermissions(siteClient, contentType, contentID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Permissions",
+			"Could not delete "+contentType+" permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *permissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *permissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'contentType:contentID' or 'contentType:contentID:site'",
+		)
+		return
+	}
+	if _, ok := permissionContentTypes[parts[0]]; !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Content type must be one of "+strings.Join(getPermissionContentTypes(), "/")+", got "+parts[0],
+		)
+		return
+	}
+	site := ""
+	if len(parts) > 2 {
+		site = parts[2]
+	}
+	siteClient, err := r.client.ResolveSiteClient(site)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	id := getSiteScopedID(GetCombinedID(parts[0], parts[1]), getClientSiteID(r.client, siteClient))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if site != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	}
+}
+
+// reconcilePermissions makes the permissions of the content item match the desired grantee capabilities exactly.
+func reconcilePermissions(client *Client, contentType, contentID string, granteeCapabilities []GranteeCapabilityModel) error {
+	desired, err := getGrantedCapabilitiesFromModels(granteeCapabilities)
+	if err != nil {
+		return err
+	}
+	perms, err := client.GetPermissions(contentType, contentID)
+	if err != nil {
+		return err
+	}
+
+	return ReconcileGrantedCapabilities(GetGrantedCapabilities(perms.GranteeCapabilities), desired,
+		func(granteeCapabilities []GranteeCapability) error {
+			_, err := client.CreatePermissions(contentType, contentID, Permissions{
+				GranteeCapabilities: granteeCapabilities,
+			})
+			return err
+		},
+		func(g GrantedCapability) error {
+			return client.DeletePermission(contentType, contentID, g.EntityType, g.EntityID, g.CapabilityName, g.CapabilityMode)
+		},
+	)
+}
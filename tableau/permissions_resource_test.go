@@ -0,0 +1,89 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test_perms_project" {
+  name = "test_project_permissions_document"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_user" "new_person" {
+  name = "test_person_perms_document@test.test"
+  full_name = "test_person_perms_document@test.test"
+  email = "test_person_perms_document@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+resource "tableau_permissions" "test_permissions" {
+  content_type = "project"
+  content_id = tableau_project.test_perms_project.id
+  grantee_capabilities = [
+    {
+      user_id = tableau_user.new_person.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Allow" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_permissions.test_permissions", "id"),
+					resource.TestCheckResourceAttr("tableau_permissions.test_permissions", "content_type", "project"),
+					resource.TestCheckResourceAttr("tableau_permissions.test_permissions", "grantee_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("tableau_permissions.test_permissions", "grantee_capabilities.0.capabilities.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_permissions.test_permissions",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test_perms_project" {
+  name = "test_project_permissions_document"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_user" "new_person" {
+  name = "test_person_perms_document@test.test"
+  full_name = "test_person_perms_document@test.test"
+  email = "test_person_perms_document@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+resource "tableau_permissions" "test_permissions" {
+  content_type = "project"
+  content_id = tableau_project.test_perms_project.id
+  grantee_capabilities = [
+    {
+      user_id = tableau_user.new_person.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_permissions.test_permissions", "grantee_capabilities.0.capabilities.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
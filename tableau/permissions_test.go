@@ -0,0 +1,48 @@
+package tableau
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.19/sites/site-id/flows/flow-id/permissions" {
+			http.NotFound(w, r)
+			return
+		}
+		// The first grantee names neither a user nor a group
+		_, _ = w.Write([]byte(`{"permissions":{"granteeCapabilities":[
+			{"capabilities":{"capability":[{"name":"Read","mode":"Allow"}]}},
+			{"group":{"id":"group-id"},"capabilities":{"capability":[{"name":"Read","mode":"Allow"},{"name":"Execute","mode":"Deny"}]}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		ApiUrl:     server.URL + "/api/3.19/sites/site-id",
+	}
+
+	flowID, granted := getGrantedCapabilityFromID(getFlowPermissionID("flow-id", "groups", "group-id", "Execute", "Deny"))
+	if flowID != "flow-id" {
+		t.Fatalf("expected flow-id, got %s", flowID)
+	}
+	permission, err := client.GetPermission("flow", flowID, granted)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if permission == nil || *permission != granted {
+		t.Errorf("expected %s, got %v", granted, permission)
+	}
+
+	granted.CapabilityMode = "Allow"
+	permission, err = client.GetPermission("flow", flowID, granted)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if permission != nil {
+		t.Errorf("expected no permission, got %s", permission)
+	}
+}
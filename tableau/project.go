@@ -15,6 +15,8 @@ type Project struct {
 	Description        string `json:"description"`
 	ContentPermissions string `json:"contentPermissions,omitempty"`
 	Owner              Owner  `json:"owner,omitempty"`
+	// ControllingPermissionsProjectID is returned by Query Projects, never sent
+	ControllingPermissionsProjectID string `json:"controllingPermissionsProjectId,omitempty"`
 }
 
 type ProjectRequest struct {
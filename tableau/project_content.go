@@ -0,0 +1,136 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ProjectContent is the content placed directly in a project.
+type ProjectContent struct {
+	Workbooks          []Workbook
+	Datasources        []Datasource
+	Flows              []Flow
+	VirtualConnections []VirtualConnection
+	Projects           []Project
+}
+
+// IsEmpty returns whether the project holds no content and no child projects.
+func (p *ProjectContent) IsEmpty() bool {
+	return len(p.Workbooks) == 0 && len(p.Datasources) == 0 && len(p.Flows) == 0 && len(p.VirtualConnections) == 0 && len(p.Projects) == 0
+}
+
+func (p *ProjectContent) String() string {
+	return fmt.Sprintf("%d workbooks, %d data sources, %d flows, %d virtual connections and %d child projects",
+		len(p.Workbooks), len(p.Datasources), len(p.Flows), len(p.VirtualConnections), len(p.Projects))
+}
+
+// GetProjectContent lists the content of the site placed directly in the project.
+func (c *Client) GetProjectContent(projectID string) (*ProjectContent, error) {
+	content := ProjectContent{}
+
+	workbooks, err := c.GetWorkbooks()
+	if err != nil {
+		return nil, err
+	}
+	for _, workbook := range workbooks {
+		if workbook.Project.ID == projectID {
+			content.Workbooks = append(content.Workbooks, workbook)
+		}
+	}
+
+	datasources, err := c.GetDatasources()
+	if err != nil {
+		return nil, err
+	}
+	for _, datasource := range datasources {
+		if datasource.Project.ID == projectID {
+			content.Datasources = append(content.Datasources, datasource)
+		}
+	}
+
+	flows, err := c.GetFlows()
+	if err != nil {
+		return nil, err
+	}
+	for _, flow := range flows {
+		if flow.Project.ID == projectID {
+			content.Flows = append(content.Flows, flow)
+		}
+	}
+
+	virtualConnections, err := c.GetVirtualConnections()
+	if err != nil {
+		return nil, err
+	}
+	for _, virtualConnection := range virtualConnections {
+		if virtualConnection.Project.ID == projectID {
+			content.VirtualConnections = append(content.VirtualConnections, virtualConnection)
+		}
+	}
+
+	projects, err := c.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.ParentProjectID == projectID {
+			content.Projects = append(content.Projects, project)
+		}
+	}
+
+	return &content, nil
+}
+
+// MoveProjectContent moves the content and child projects to the target project.
+func (c *Client) MoveProjectContent(content *ProjectContent, targetProjectID string) error {
+	target := map[string]string{"id": targetProjectID}
+	for _, workbook := range content.Workbooks {
+		err := c.moveContent("workbooks", "workbook", workbook.ID, map[string]any{"project": target})
+		if err != nil {
+			return fmt.Errorf("could not move workbook %s: %w", workbook.Name, err)
+		}
+	}
+	for _, datasource := range content.Datasources {
+		err := c.moveContent("datasources", "datasource", datasource.ID, map[string]any{"project": target})
+		if err != nil {
+			return fmt.Errorf("could not move data source %s: %w", datasource.Name, err)
+		}
+	}
+	for _, flow := range content.Flows {
+		err := c.moveContent("flows", "flow", flow.ID, map[string]any{"project": target})
+		if err != nil {
+			return fmt.Errorf("could not move flow %s: %w", flow.Name, err)
+		}
+	}
+	for _, virtualConnection := range content.VirtualConnections {
+		err := c.moveContent("virtualconnections", "virtualConnection", virtualConnection.ID, map[string]any{"project": target})
+		if err != nil {
+			return fmt.Errorf("could not move virtual connection %s: %w", virtualConnection.Name, err)
+		}
+	}
+	for _, project := range content.Projects {
+		err := c.moveContent("projects", "project", project.ID, map[string]any{"parentProjectId": targetProjectID})
+		if err != nil {
+			return fmt.Errorf("could not move project %s: %w", project.Name, err)
+		}
+	}
+	return nil
+}
+
+// moveContent updates only the location of a content item, leaving its other attributes untouched.
+func (c *Client) moveContent(contentPath, contentKey, contentID string, location map[string]any) error {
+	moveJson, err := json.Marshal(map[string]any{contentKey: location})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s/%s", c.ApiUrl, contentPath, contentID), strings.NewReader(string(moveJson)))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -3,14 +3,17 @@ package tableau
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &projectDataSource{}
-	_ datasource.DataSourceWithConfigure = &projectDataSource{}
+	_ datasource.DataSource                     = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure        = &projectDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &projectDataSource{}
 )
 
 func ProjectDataSource() datasource.DataSource {
@@ -22,7 +25,9 @@ type projectDataSource struct {
 }
 
 type projectDataSourceModel struct {
+	Site               types.String `tfsdk:"site"`
 	ID                 types.String `tfsdk:"id"`
+	Path               types.String `tfsdk:"path"`
 	Name               types.String `tfsdk:"name"`
 	Description        types.String `tfsdk:"description"`
 	ContentPermissions types.String `tfsdk:"content_permissions"`
@@ -35,12 +40,19 @@ func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieve project details",
+		Description: "Retrieve project details by ID or path",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "ID of the project",
 			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Names of the project and its ancestors joined with slashes, for example Finance/EMEA/Reports, a path matching several projects because a name contains a slash is rejected",
+			},
 			"name": schema.StringAttribute{
 				Computed:    true,
 				Description: "Name for the project",
@@ -61,12 +73,35 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	}
 }
 
+func (d *projectDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("path"),
+		),
+	}
+}
+
 func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state projectDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	project, err := d.client.GetProject(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	var node *ProjectTreeNode
+	if !state.Path.IsNull() {
+		node, err = siteClient.GetProjectByPath(state.Path.ValueString())
+	} else {
+		node, err = siteClient.GetProjectTreeNode(state.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project",
@@ -75,7 +110,9 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	project := node.Project
 	state.ID = types.StringValue(project.ID)
+	state.Path = types.StringValue(node.Path)
 	state.Name = types.StringValue(project.Name)
 	state.Description = types.StringValue(project.Description)
 	state.ContentPermissions = types.StringValue(project.ContentPermissions)
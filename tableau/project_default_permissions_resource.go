@@ -0,0 +1,349 @@
+package tableau
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &projectDefaultPermissionsResource{}
+	_ resource.ResourceWithConfigure      = &projectDefaultPermissionsResource{}
+	_ resource.ResourceWithImportState    = &projectDefaultPermissionsResource{}
+	_ resource.ResourceWithModifyPlan     = &projectDefaultPermissionsResource{}
+	_ resource.ResourceWithValidateConfig = &projectDefaultPermissionsResource{}
+)
+
+func NewProjectDefaultPermissionsResource() resource.Resource {
+	return &projectDefaultPermissionsResource{}
+}
+
+type projectDefaultPermissionsResource struct {
+	client *Client
+}
+
+type projectDefaultPermissionsResourceModel struct {
+	ID                  types.String             `tfsdk:"id"`
+	ProjectID           types.String             `tfsdk:"project_id"`
+	TargetType          types.String             `tfsdk:"target_type"`
+	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
+	Site                types.String             `tfsdk:"site"`
+	LastUpdated         types.String             `tfsdk:"last_updated"`
+}
+
+func (r *projectDefaultPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_default_permissions"
+}
+
+func (r *projectDefaultPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritative default permissions a project applies to one content type. Grants that are not declared are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Default permissions for: " + strings.Join(defaultPermissionTargetTypes, ","),
+				Validators: []validator.String{
+					stringvalidator.OneOf(defaultPermissionTargetTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grantee_capabilities": granteeCapabilitiesResourceAttribute(),
+			"site":                 siteAttribute(),
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the default permissions",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *projectDefaultPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var targetType types.String
+	var granteeCapabilities types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_type"), &targetType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("grantee_capabilities"), &granteeCapabilities)...)
+	if resp.Diagnostics.HasError() || targetType.IsUnknown() || targetType.IsNull() || granteeCapabilities.IsUnknown() || granteeCapabilities.IsNull() {
+		return
+	}
+	capabilities, ok := contentTypeCapabilities[getDefaultPermissionContentType(targetType.ValueString())]
+	if !ok {
+		return
+	}
+
+	granted, diags := getKnownGrantedCapabilities(ctx, granteeCapabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, g := range granted {
+		if slices.Contains(capabilities, g.CapabilityName) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grantee_capabilities"),
+			"Invalid capability",
+			"Capability "+g.CapabilityName+" does not apply to "+targetType.ValueString()+", use one of "+strings.Join(capabilities, "/"),
+		)
+	}
+}
+
+func (r *projectDefaultPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	validatePermissionsSiteRoles(ctx, getPlanSiteClient(ctx, r.client, req), req, resp)
+}
+
+func (r *projectDefaultPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectDefaultPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	targetType := plan.TargetType.ValueString()
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = reconcileDefaultPermissions(siteClient, projectID, targetType, plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project default permissions",
+			"Could not create project default permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(getSiteScopedID(GetCombinedID(projectID, targetType), getClientSiteID(r.client, siteClient)))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectDefaultPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsID, siteID := splitSiteScopedID(state.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	projectID, targetType := GetIDsFromCombinedID(permissionsID)
+	perms, err := siteClient.GetDefaultPermissions(projectID, targetType)
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ProjectID = types.StringValue(projectID)
+	state.TargetType = types.StringValue(targetType)
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
+	state.GranteeCapabilities = getGranteeCapabilityModels(perms.GranteeCapabilities)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectDefaultPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, siteID := splitSiteScopedID(plan.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = reconcileDefaultPermissions(siteClient, plan.ProjectID.ValueString(), plan.TargetType.ValueString(), plan.GranteeCapabilities)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Project Default Permissions",
+			"Could not update project default permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectDefaultPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectDefaultPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionsID, siteID := splitSiteScopedID(state.ID.ValueString(), 2)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	projectID, targetType := GetIDsFromCombinedID(permissionsID)
+	err = reconcileDefaultPermissions(siteClient, projectID, targetType, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Project Default Permissions",
+			"Could not delete project default permissions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectDefaultPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *projectDefaultPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'projectID:targetType' or 'projectID:targetType:siteID'",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// reconcileDefaultPermissions makes the default permissions of the project match the desired grantee capabilities exactly.
+func reconcileDefaultPermissions(client *Client, projectID, targetType string, granteeCapabilities []GranteeCapabilityModel) error {
+	desired, err := getGrantedCapabilitiesFromModels(granteeCapabilities)
+	if err != nil {
+		return err
+	}
+	perms, err := client.GetDefaultPermissions(projectID, targetType)
+	if err != nil {
+		return err
+	}
+
+	return ReconcileGrantedCapabilities(GetGrantedCapabilities(perms.GranteeCapabilities), desired,
+		func(granteeCapabilities []GranteeCapability) error {
+			_, err := client.CreateDefaultPermissions(projectID, targetType, ProjectPermissions{
+				GranteeCapabilities: granteeCapabilities,
+			})
+			return err
+		},
+		func(g GrantedCapability) error {
+			return client.DeleteDefaultPermission(projectID, targetType, g.EntityType, g.EntityID, g.CapabilityName, g.CapabilityMode)
+		},
+	)
+}
+
+// granteeCapabilitiesResourceAttribute is the grantee_capabilities attribute shared by the authoritative permission resources.
+func granteeCapabilitiesResourceAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Required:    true,
+		Description: "Set of users and groups with the capabilities granted to them, one entry per grantee",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"user_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the user, conflicts with group_id",
+				},
+				"group_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the group, conflicts with user_id",
+				},
+				"capabilities": schema.SetNestedAttribute{
+					Required:    true,
+					Description: "Set of capabilities granted to the user or group",
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "Name of the capability",
+							},
+							"mode": schema.StringAttribute{
+								Required:    true,
+								Description: "Mode of the capability, Allow or Deny (case sensitive)",
+								Validators: []validator.String{
+									stringvalidator.OneOf([]string{
+										"Allow",
+										"Deny",
+									}...),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectDefaultPermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test_default_perm_project" {
+  name = "test_project_default_permissions"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_user" "new_person" {
+  name = "test_person_default_perms@test.test"
+  full_name = "test_person_default_perms@test.test"
+  email = "test_person_default_perms@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+resource "tableau_project_default_permissions" "test_permissions" {
+  project_id = tableau_project.test_default_perm_project.id
+  target_type = "workbooks"
+  grantee_capabilities = [
+    {
+      user_id = tableau_user.new_person.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+        { name = "Write", mode = "Deny" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tableau_project_default_permissions.test_permissions", "id"),
+					resource.TestCheckResourceAttr("tableau_project_default_permissions.test_permissions", "target_type", "workbooks"),
+					resource.TestCheckResourceAttr("tableau_project_default_permissions.test_permissions", "grantee_capabilities.#", "1"),
+					resource.TestCheckResourceAttr("tableau_project_default_permissions.test_permissions", "grantee_capabilities.0.capabilities.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_project_default_permissions.test_permissions",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "test_default_perm_project" {
+  name = "test_project_default_permissions"
+  content_permissions = "ManagedByOwner"
+}
+resource "tableau_user" "new_person" {
+  name = "test_person_default_perms@test.test"
+  full_name = "test_person_default_perms@test.test"
+  email = "test_person_default_perms@test.test"
+  site_role = "Creator"
+  auth_setting = "SAML"
+}
+resource "tableau_project_default_permissions" "test_permissions" {
+  project_id = tableau_project.test_default_perm_project.id
+  target_type = "workbooks"
+  grantee_capabilities = [
+    {
+      user_id = tableau_user.new_person.id
+      capabilities = [
+        { name = "Read", mode = "Allow" },
+      ]
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project_default_permissions.test_permissions", "grantee_capabilities.0.capabilities.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDefaultPermissionTargetTypesHaveCapabilities(t *testing.T) {
+	for _, targetType := range defaultPermissionTargetTypes {
+		if _, ok := contentTypeCapabilities[getDefaultPermissionContentType(targetType)]; !ok {
+			t.Errorf("target type %s has no capabilities to validate against", targetType)
+		}
+	}
+	if contentType := getDefaultPermissionContentType("virtualconnections"); contentType != "virtual_connection" {
+		t.Errorf("expected virtual_connection, got %s", contentType)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &projectPermissionResource{}
-	_ resource.ResourceWithConfigure   = &projectPermissionResource{}
-	_ resource.ResourceWithImportState = &projectPermissionResource{}
+	_ resource.Resource                     = &projectPermissionResource{}
+	_ resource.ResourceWithConfigure        = &projectPermissionResource{}
+	_ resource.ResourceWithImportState      = &projectPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &projectPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &projectPermissionResource{}
 )
 
 func NewProjectPermissionResource() resource.Resource {
@@ -35,6 +36,8 @@ type projectPermissionResourceModel struct {
 	ProjectID      types.String `tfsdk:"project_id"`
 	UserID         types.String `tfsdk:"user_id"`
 	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
 }
@@ -62,27 +65,27 @@ func (r *projectPermissionResource) Schema(_ context.Context, _ resource.SchemaR
 			},
 			"user_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "User ID to grant to",
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Group ID to grant to",
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
 			"capability_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The capability to assign permissions to, one of ProjectLeader/Read/Write",
+				Description: capabilityNameDescription("project"),
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"ProjectLeader",
-						"Read",
-						"Write",
-					}...),
+					capabilityNameValidator("project"),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -105,6 +108,17 @@ func (r *projectPermissionResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *projectPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *projectPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
 func (r *projectPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -113,6 +127,19 @@ func (r *projectPermissionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectID := plan.ProjectID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -138,7 +165,7 @@ func (r *projectPermissionResource) Create(ctx context.Context, req resource.Cre
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateProjectPermissions(projectID, projectPermissions)
+	_, err = siteClient.CreateProjectPermissions(projectID, projectPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project permission",
@@ -147,7 +174,7 @@ func (r *projectPermissionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	plan.ID = types.StringValue(getProjectPermissionID(projectID, entityType, entityID, capability.Name, capability.Mode))
+	plan.ID = types.StringValue(getSiteScopedID(getProjectPermissionID(projectID, entityType, entityID, capability.Name, capability.Mode), getClientSiteID(r.client, siteClient)))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -164,7 +191,17 @@ func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	permission, err := getProjectPermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission, err := getProjectPermissionFromID(permissionID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Project",
@@ -172,7 +209,7 @@ func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadR
 		)
 		return
 	}
-	projectPermission, err := r.client.GetProjectPermission(permission.ProjectID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	projectPermission, err := siteClient.GetProjectPermission(permission.ProjectID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -183,7 +220,10 @@ func (r *projectPermissionResource) Read(ctx context.Context, req resource.ReadR
 	} else {
 		state.GroupID = types.StringValue(projectPermission.EntityID)
 	}
-	state.ID = types.StringValue(getProjectPermissionID(projectPermission.ProjectID, projectPermission.EntityType, projectPermission.EntityID, projectPermission.CapabilityName, projectPermission.CapabilityMode))
+	state.ID = types.StringValue(getSiteScopedID(getProjectPermissionID(projectPermission.ProjectID, projectPermission.EntityType, projectPermission.EntityID, projectPermission.CapabilityName, projectPermission.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
 	state.ProjectID = types.StringValue(projectPermission.ProjectID)
 	state.CapabilityName = types.StringValue(projectPermission.CapabilityName)
 	state.CapabilityMode = types.StringValue(projectPermission.CapabilityMode)
@@ -218,7 +258,17 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	permission, err := getProjectPermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission, err := getProjectPermissionFromID(permissionID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Project",
@@ -227,7 +277,7 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 	if permission.EntityType == "users" {
-		err := r.client.DeleteProjectPermission(&permission.EntityID, nil, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteProjectPermission(&permission.EntityID, nil, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Project",
@@ -236,7 +286,7 @@ func (r *projectPermissionResource) Delete(ctx context.Context, req resource.Del
 			return
 		}
 	} else {
-		err := r.client.DeleteProjectPermission(nil, &permission.EntityID, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteProjectPermission(nil, &permission.EntityID, permission.ProjectID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Project",
@@ -256,7 +306,7 @@ func (r *projectPermissionResource) Configure(_ context.Context, req resource.Co
 }
 
 func (r *projectPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importPermissionState(ctx, r.client, req, resp, "project", (*Client).ResolveProjectImportID, getProjectPermissionID)
 }
 
 func getProjectPermissionID(projectID, entityType, entityID, capabilityName, capabilityMode string) string {
@@ -22,6 +22,7 @@ type projectPermissionsDataSource struct {
 }
 
 type projectPermissionsDataSourceModel struct {
+	Site                types.String             `tfsdk:"site"`
 	ID                  types.String             `tfsdk:"id"`
 	GranteeCapabilities []GranteeCapabilityModel `tfsdk:"grantee_capabilities"`
 }
@@ -34,6 +35,7 @@ func (d *projectPermissionsDataSource) Schema(_ context.Context, _ datasource.Sc
 	resp.Schema = schema.Schema{
 		Description: "Retrieve project details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the project",
@@ -79,7 +81,16 @@ func (d *projectPermissionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	perms, err := d.client.GetProjectPermissions(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	perms, err := siteClient.GetProjectPermissions(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Project Permissions",
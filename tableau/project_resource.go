@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &projectResource{}
 	_ resource.ResourceWithConfigure   = &projectResource{}
 	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithModifyPlan  = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -30,13 +33,21 @@ type projectResource struct {
 }
 
 type projectResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	ParentProjectID    types.String `tfsdk:"parent_project_id"`
-	Description        types.String `tfsdk:"description"`
-	ContentPermissions types.String `tfsdk:"content_permissions"`
-	OwnerID            types.String `tfsdk:"owner_id"`
-	LastUpdated        types.String `tfsdk:"last_updated"`
+	ID                 types.String                  `tfsdk:"id"`
+	Site               types.String                  `tfsdk:"site"`
+	Name               types.String                  `tfsdk:"name"`
+	ParentProjectID    types.String                  `tfsdk:"parent_project_id"`
+	Description        types.String                  `tfsdk:"description"`
+	ContentPermissions types.String                  `tfsdk:"content_permissions"`
+	OwnerID            types.String                  `tfsdk:"owner_id"`
+	DeletionProtection types.Bool                    `tfsdk:"deletion_protection"`
+	ContentOnDestroy   *projectContentOnDestroyModel `tfsdk:"content_on_destroy"`
+	LastUpdated        types.String                  `tfsdk:"last_updated"`
+}
+
+type projectContentOnDestroyModel struct {
+	MoveTo         types.String `tfsdk:"move_to"`
+	FailIfNotEmpty types.Bool   `tfsdk:"fail_if_not_empty"`
 }
 
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,6 +64,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": siteAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Display name for project",
@@ -86,6 +98,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"content_on_destroy": projectContentOnDestroyAttribute(),
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether Terraform is prevented from destroying the project, set it to false and apply before destroying the project",
+			},
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of the last Terraform update of the project",
@@ -97,6 +116,10 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -105,6 +128,15 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	project := Project{
 		Name:               plan.Name.ValueString(),
 		Description:        plan.Description.ValueString(),
@@ -113,7 +145,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		Owner:              Owner{ID: plan.OwnerID.ValueString()},
 	}
 
-	createdProject, err := r.client.CreateProject(project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
+	createdProject, err := siteClient.CreateProject(project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating project",
@@ -141,7 +173,16 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.client.GetProject(state.ID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	project, err := siteClient.GetProject(state.ID.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -155,6 +196,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.OwnerID = types.StringValue(project.Owner.ID)
 	state.Description = types.StringValue(project.Description)
 	state.ContentPermissions = types.StringValue(project.ContentPermissions)
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -171,6 +215,15 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	project := Project{
 		Name:               plan.Name.ValueString(),
 		Description:        plan.Description.ValueString(),
@@ -178,7 +231,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		ParentProjectID:    plan.ParentProjectID.ValueString(),
 		Owner:              Owner{ID: plan.OwnerID.ValueString()},
 	}
-	_, err := r.client.UpdateProject(plan.ID.ValueString(), project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
+	_, err = siteClient.UpdateProject(plan.ID.ValueString(), project.Name, project.ParentProjectID, project.Description, project.ContentPermissions, project.Owner.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Project",
@@ -187,7 +240,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updatedProject, err := r.client.GetProject(plan.ID.ValueString())
+	updatedProject, err := siteClient.GetProject(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Project",
@@ -220,7 +273,29 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteProject(state.ID.ValueString())
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Project",
+			"Could not delete project "+state.Name.ValueString()+", deletion_protection is enabled. Set deletion_protection to false and apply before destroying the project.",
+		)
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(handleProjectContentOnDestroy(siteClient, state.ID.ValueString(), state.ContentOnDestroy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = siteClient.DeleteProject(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau Project",
@@ -239,5 +314,79 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importSiteContentState(ctx, r.client, req, resp, "projectID or project path", (*Client).ResolveProjectImportID)
+}
+
+func projectContentOnDestroyAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Handling of the workbooks, data sources, flows, virtual connections and child projects left in the project when it is destroyed, by default they are deleted with the project",
+		Attributes: map[string]schema.Attribute{
+			"move_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the project the content is moved to before the project is deleted, outside of the project and its nested projects",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("fail_if_not_empty")),
+				},
+			},
+			"fail_if_not_empty": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether deleting the project fails while it holds content",
+			},
+		},
+	}
+}
+
+// handleProjectContentOnDestroy moves the content out of a project or fails while it holds content, as configured by content_on_destroy.
+func handleProjectContentOnDestroy(client *Client, projectID string, contentOnDestroy *projectContentOnDestroyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if contentOnDestroy == nil || (contentOnDestroy.MoveTo.ValueString() == "" && !contentOnDestroy.FailIfNotEmpty.ValueBool()) {
+		return diags
+	}
+
+	content, err := client.GetProjectContent(projectID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau Project Content",
+			"Could not read content of project ID "+projectID+": "+err.Error(),
+		)
+		return diags
+	}
+	if content.IsEmpty() {
+		return diags
+	}
+
+	if contentOnDestroy.FailIfNotEmpty.ValueBool() {
+		diags.AddError(
+			"Error Deleting Tableau Project",
+			"Could not delete project ID "+projectID+", it still holds "+content.String()+" and content_on_destroy.fail_if_not_empty is set",
+		)
+		return diags
+	}
+
+	moveTo := contentOnDestroy.MoveTo.ValueString()
+	nodes, err := client.GetProjectTree()
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau Projects",
+			"Could not read projects: "+err.Error(),
+		)
+		return diags
+	}
+	// The nested projects are deleted with the project, content moved there would be deleted too
+	if IsProjectInSubtree(nodes, projectID, moveTo) {
+		diags.AddError(
+			"Error Deleting Tableau Project",
+			"Could not move content of project ID "+projectID+" to project ID "+moveTo+", content_on_destroy.move_to must be a project outside of the destroyed project and its nested projects",
+		)
+		return diags
+	}
+	err = client.MoveProjectContent(content, moveTo)
+	if err != nil {
+		diags.AddError(
+			"Error Moving Tableau Project Content",
+			"Could not move content of project ID "+projectID+" to project ID "+moveTo+": "+err.Error(),
+		)
+	}
+	return diags
 }
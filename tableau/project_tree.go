@@ -0,0 +1,133 @@
+package tableau
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProjectTreeNode is a project placed in the project hierarchy of its site.
+type ProjectTreeNode struct {
+	Project Project
+	// Path joins the names of the project and its ancestors with slashes, for example Finance/EMEA/Reports.
+	// Names are not escaped, the path of a project whose name contains a slash may be the path of another project.
+	Path                            string
+	Depth                           int
+	ChildProjectIDs                 []string
+	ControllingPermissionsProjectID string
+}
+
+// BuildProjectTree places the projects in their hierarchy, sorted by path.
+// Projects whose parent is not in the list are placed at the top level.
+// The controlling project returned by the server is kept, it is derived from the ancestors when the server does not return it.
+func BuildProjectTree(projects []Project) []ProjectTreeNode {
+	projectsByID := map[string]*Project{}
+	for i := range projects {
+		projectsByID[projects[i].ID] = &projects[i]
+	}
+
+	nodes := make([]ProjectTreeNode, 0, len(projects))
+	for _, project := range projects {
+		node := ProjectTreeNode{
+			Project:                         project,
+			ChildProjectIDs:                 []string{},
+			ControllingPermissionsProjectID: project.ID,
+		}
+		names := []string{project.Name}
+		locked := project.ContentPermissions == "LockedToProject" || project.ContentPermissions == "LockedToProjectWithoutNested"
+		visited := map[string]bool{project.ID: true}
+		for parent, ok := projectsByID[project.ParentProjectID]; ok && !visited[parent.ID]; parent, ok = projectsByID[parent.ParentProjectID] {
+			visited[parent.ID] = true
+			names = append([]string{parent.Name}, names...)
+			// The closest ancestor locking its nested projects controls the permissions
+			if !locked && parent.ContentPermissions == "LockedToProject" {
+				node.ControllingPermissionsProjectID = parent.ID
+				locked = true
+			}
+		}
+		if project.ControllingPermissionsProjectID != "" {
+			node.ControllingPermissionsProjectID = project.ControllingPermissionsProjectID
+		}
+		node.Path = strings.Join(names, "/")
+		node.Depth = len(names) - 1
+		for _, child := range projects {
+			if child.ParentProjectID == project.ID {
+				node.ChildProjectIDs = append(node.ChildProjectIDs, child.ID)
+			}
+		}
+		sort.Strings(node.ChildProjectIDs)
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Path < nodes[j].Path
+	})
+	return nodes
+}
+
+func (c *Client) GetProjectTree() ([]ProjectTreeNode, error) {
+	projects, err := c.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	return BuildProjectTree(projects), nil
+}
+
+// GetProjectByPath returns the project with the given path, see ProjectTreeNode.
+func (c *Client) GetProjectByPath(path string) (*ProjectTreeNode, error) {
+	nodes, err := c.GetProjectTree()
+	if err != nil {
+		return nil, err
+	}
+	return findProjectTreeNodeByPath(nodes, path)
+}
+
+// findProjectTreeNodeByPath returns the project with the given path.
+// A path matching several projects, because a project name contains a slash, is rejected.
+func findProjectTreeNodeByPath(nodes []ProjectTreeNode, path string) (*ProjectTreeNode, error) {
+	path = strings.Trim(path, "/")
+	var match *ProjectTreeNode
+	for i, node := range nodes {
+		if node.Path != path {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("found several projects with path %s, a project name contains a slash", path)
+		}
+		match = &nodes[i]
+	}
+	if match == nil {
+		return nil, fmt.Errorf("did not find project with path %s", path)
+	}
+	return match, nil
+}
+
+// GetProjectTreeNode returns the project with the given ID placed in its hierarchy.
+func (c *Client) GetProjectTreeNode(projectID string) (*ProjectTreeNode, error) {
+	nodes, err := c.GetProjectTree()
+	if err != nil {
+		return nil, err
+	}
+	for i, node := range nodes {
+		if node.Project.ID == projectID {
+			return &nodes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find project ID %s", projectID)
+}
+
+// IsProjectInSubtree reports whether the project is the root project or one of its nested projects.
+func IsProjectInSubtree(nodes []ProjectTreeNode, rootProjectID, projectID string) bool {
+	parentIDs := map[string]string{}
+	for _, node := range nodes {
+		parentIDs[node.Project.ID] = node.Project.ParentProjectID
+	}
+	visited := map[string]bool{}
+	for id := projectID; id != "" && !visited[id]; id = parentIDs[id] {
+		if id == rootProjectID {
+			return true
+		}
+		visited[id] = true
+	}
+	return false
+}
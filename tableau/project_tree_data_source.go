@@ -0,0 +1,155 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &projectTreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectTreeDataSource{}
+)
+
+func ProjectTreeDataSource() datasource.DataSource {
+	return &projectTreeDataSource{}
+}
+
+type projectTreeDataSource struct {
+	client *Client
+}
+
+type projectTreeNestedDataModel struct {
+	ID                              types.String   `tfsdk:"id"`
+	Name                            types.String   `tfsdk:"name"`
+	Path                            types.String   `tfsdk:"path"`
+	Depth                           types.Int64    `tfsdk:"depth"`
+	ParentProjectID                 types.String   `tfsdk:"parent_project_id"`
+	ChildProjectIDs                 []types.String `tfsdk:"child_project_ids"`
+	ContentPermissions              types.String   `tfsdk:"content_permissions"`
+	ControllingPermissionsProjectID types.String   `tfsdk:"controlling_permissions_project_id"`
+}
+
+type projectTreeDataSourceModel struct {
+	Site     types.String                 `tfsdk:"site"`
+	ID       types.String                 `tfsdk:"id"`
+	Projects []projectTreeNestedDataModel `tfsdk:"projects"`
+}
+
+func (d *projectTreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tree"
+}
+
+func (d *projectTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the project hierarchy of a site, projects are sorted by path",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the project tree",
+			},
+			"projects": schema.ListNestedAttribute{
+				Description: "List of projects and their place in the hierarchy",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Project ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Project name",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "Names of the project and its ancestors joined with slashes, for example Finance/EMEA/Reports, names are not escaped",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Nesting level of the project, 0 for top level projects",
+							Computed:    true,
+						},
+						"parent_project_id": schema.StringAttribute{
+							Description: "Parent project ID",
+							Computed:    true,
+						},
+						"child_project_ids": schema.ListAttribute{
+							Description: "IDs of the projects nested directly in the project",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"content_permissions": schema.StringAttribute{
+							Description: "Permissions for the project content",
+							Computed:    true,
+						},
+						"controlling_permissions_project_id": schema.StringAttribute{
+							Description: "ID of the project controlling the permissions of the project content, the project itself unless an ancestor locks the permissions of its nested projects",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectTreeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	nodes, err := siteClient.GetProjectTree()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Project Tree",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Projects = []projectTreeNestedDataModel{}
+	for _, node := range nodes {
+		projectState := projectTreeNestedDataModel{
+			ID:                              types.StringValue(node.Project.ID),
+			Name:                            types.StringValue(node.Project.Name),
+			Path:                            types.StringValue(node.Path),
+			Depth:                           types.Int64Value(int64(node.Depth)),
+			ParentProjectID:                 types.StringValue(node.Project.ParentProjectID),
+			ChildProjectIDs:                 []types.String{},
+			ContentPermissions:              types.StringValue(node.Project.ContentPermissions),
+			ControllingPermissionsProjectID: types.StringValue(node.ControllingPermissionsProjectID),
+		}
+		for _, childProjectID := range node.ChildProjectIDs {
+			projectState.ChildProjectIDs = append(projectState.ChildProjectIDs, types.StringValue(childProjectID))
+		}
+		state.Projects = append(state.Projects, projectState)
+	}
+
+	state.ID = types.StringValue("projectTree")
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *projectTreeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
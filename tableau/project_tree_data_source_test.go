@@ -0,0 +1,39 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectTreeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                resource "tableau_project" "parent" {
+                    name = "test_project_tree_parent"
+                    content_permissions = "LockedToProject"
+                }
+                resource "tableau_project" "child" {
+                    name = "test_project_tree_child"
+                    content_permissions = "ManagedByOwner"
+                    parent_project_id = tableau_project.parent.id
+                }
+                data "tableau_project_tree" "test" {
+                    depends_on = [tableau_project.child]
+                }
+                data "tableau_project" "test" {
+                    path = "test_project_tree_parent/test_project_tree_child"
+                    depends_on = [tableau_project.child]
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_project_tree.test", "projects.#"),
+					resource.TestCheckResourceAttrPair("data.tableau_project.test", "id", "tableau_project.child", "id"),
+					resource.TestCheckResourceAttrPair("data.tableau_project.test", "parent_project_id", "tableau_project.parent", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package tableau
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuildProjectTree(t *testing.T) {
+	projects := []Project{
+		{ID: "reports", Name: "Reports", ParentProjectID: "emea", ContentPermissions: "ManagedByOwner"},
+		{ID: "finance", Name: "Finance", ContentPermissions: "LockedToProject"},
+		{ID: "emea", Name: "EMEA", ParentProjectID: "finance", ContentPermissions: "ManagedByOwner"},
+		{ID: "sales", Name: "Sales", ContentPermissions: "LockedToProjectWithoutNested"},
+		{ID: "apac", Name: "Reports", ParentProjectID: "sales", ContentPermissions: "ManagedByOwner"},
+	}
+
+	nodes := BuildProjectTree(projects)
+
+	paths := []string{}
+	for _, node := range nodes {
+		paths = append(paths, node.Path)
+	}
+	if !slices.Equal(paths, []string{"Finance", "Finance/EMEA", "Finance/EMEA/Reports", "Sales", "Sales/Reports"}) {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+
+	tests := []struct {
+		path        string
+		depth       int
+		children    []string
+		controlling string
+	}{
+		{path: "Finance", depth: 0, children: []string{"emea"}, controlling: "finance"},
+		{path: "Finance/EMEA", depth: 1, children: []string{"reports"}, controlling: "finance"},
+		{path: "Finance/EMEA/Reports", depth: 2, children: []string{}, controlling: "finance"},
+		{path: "Sales", depth: 0, children: []string{"apac"}, controlling: "sales"},
+		{path: "Sales/Reports", depth: 1, children: []string{}, controlling: "apac"},
+	}
+	for i, test := range tests {
+		node := nodes[i]
+		if node.Depth != test.depth {
+			t.Errorf("%s: expected depth %d, got %d", test.path, test.depth, node.Depth)
+		}
+		if !slices.Equal(node.ChildProjectIDs, test.children) {
+			t.Errorf("%s: expected children %v, got %v", test.path, test.children, node.ChildProjectIDs)
+		}
+		if node.ControllingPermissionsProjectID != test.controlling {
+			t.Errorf("%s: expected controlling project %s, got %s", test.path, test.controlling, node.ControllingPermissionsProjectID)
+		}
+	}
+}
+
+func TestBuildProjectTreeKeepsControllingProjectOfServer(t *testing.T) {
+	nodes := BuildProjectTree([]Project{
+		{ID: "finance", Name: "Finance", ContentPermissions: "LockedToProject", ControllingPermissionsProjectID: "finance"},
+		{ID: "emea", Name: "EMEA", ParentProjectID: "finance", ContentPermissions: "ManagedByOwner", ControllingPermissionsProjectID: "emea"},
+		{ID: "sales", Name: "Sales", ContentPermissions: "ManagedByOwner"},
+		{ID: "apac", Name: "APAC", ParentProjectID: "sales", ContentPermissions: "ManagedByOwner"},
+	})
+
+	expected := map[string]string{"finance": "finance", "emea": "emea", "sales": "sales", "apac": "apac"}
+	for _, node := range nodes {
+		if node.ControllingPermissionsProjectID != expected[node.Project.ID] {
+			t.Errorf("%s: expected controlling project %s, got %s", node.Path, expected[node.Project.ID], node.ControllingPermissionsProjectID)
+		}
+	}
+}
+
+func TestFindProjectTreeNodeByPath(t *testing.T) {
+	nodes := BuildProjectTree([]Project{
+		{ID: "finance", Name: "Finance"},
+		{ID: "emea", Name: "EMEA", ParentProjectID: "finance"},
+		{ID: "finance-emea", Name: "Finance/EMEA"},
+		{ID: "reports", Name: "Reports", ParentProjectID: "emea"},
+	})
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "Finance", expected: "finance"},
+		{path: "/Finance/EMEA/Reports/", expected: "reports"},
+		{path: "Finance/EMEA", expected: ""},
+		{path: "Sales", expected: ""},
+	}
+	for _, test := range tests {
+		node, err := findProjectTreeNodeByPath(nodes, test.path)
+		if test.expected == "" {
+			if err == nil {
+				t.Errorf("%s: expected an error, got project %s", test.path, node.Project.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.path, err)
+		} else if node.Project.ID != test.expected {
+			t.Errorf("%s: expected project %s, got %s", test.path, test.expected, node.Project.ID)
+		}
+	}
+}
+
+func TestIsProjectInSubtree(t *testing.T) {
+	nodes := BuildProjectTree([]Project{
+		{ID: "finance", Name: "Finance"},
+		{ID: "emea", Name: "EMEA", ParentProjectID: "finance"},
+		{ID: "reports", Name: "Reports", ParentProjectID: "emea"},
+		{ID: "sales", Name: "Sales"},
+		// Projects referencing each other as parent must not loop
+		{ID: "loop-a", Name: "A", ParentProjectID: "loop-b"},
+		{ID: "loop-b", Name: "B", ParentProjectID: "loop-a"},
+	})
+
+	tests := []struct {
+		root     string
+		project  string
+		expected bool
+	}{
+		{root: "finance", project: "finance", expected: true},
+		{root: "finance", project: "emea", expected: true},
+		{root: "finance", project: "reports", expected: true},
+		{root: "emea", project: "finance", expected: false},
+		{root: "finance", project: "sales", expected: false},
+		{root: "finance", project: "unknown", expected: false},
+		{root: "finance", project: "loop-a", expected: false},
+	}
+	for _, test := range tests {
+		if actual := IsProjectInSubtree(nodes, test.root, test.project); actual != test.expected {
+			t.Errorf("expected %t for %s in subtree of %s, got %t", test.expected, test.project, test.root, actual)
+		}
+	}
+}
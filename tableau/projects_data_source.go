@@ -28,6 +28,7 @@ type projectsNestedDataModel struct {
 }
 
 type projectsDataSourceModel struct {
+	Site     types.String              `tfsdk:"site"`
 	ID       types.String              `tfsdk:"id"`
 	Projects []projectsNestedDataModel `tfsdk:"projects"`
 }
@@ -40,6 +41,7 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Retrieve project details as a list of projects available to read",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the list of projects",
@@ -73,7 +75,16 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	projects, err := d.client.GetProjects()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	projects, err := siteClient.GetProjects()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Projects",
@@ -3,18 +3,24 @@ package tableau
 import (
 	"context"
 	"os"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ provider.Provider = &tableauProvider{}
+	_ provider.Provider              = &tableauProvider{}
+	_ provider.ProviderWithFunctions = &tableauProvider{}
 )
 
 func New() provider.Provider {
@@ -61,6 +67,29 @@ func (p *tableauProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Description: "Site name from your Tableau URL - TABLEAU_SITE_NAME env var - for Tableau Server default sites leave as ''",
 			},
+			"sites": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Sites the site argument of resources and data sources can refer to by alias, in addition to their content URL or ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_url": schema.StringAttribute{
+							Required:    true,
+							Description: "Content URL of the site, '' for the default site",
+						},
+						"alias": schema.StringAttribute{
+							Optional:    true,
+							Description: "Alias resources and data sources can use as their site",
+						},
+					},
+				},
+			},
+			"site_role_validation": schema.StringAttribute{
+				Optional:    true,
+				Description: "How permission resources handle capabilities the site role of the grantee cannot use, one of off/warn/enforce, defaults to warn - TABLEAU_SITE_ROLE_VALIDATION env var",
+				Validators: []validator.String{
+					stringvalidator.OneOf(siteRoleValidationModes...),
+				},
+			},
 		},
 	}
 }
@@ -73,6 +102,13 @@ type tableauProviderModel struct {
 	PersonalAccessTokenName   types.String `tfsdk:"personal_access_token_name"`
 	PersonalAccessTokenSecret types.String `tfsdk:"personal_access_token_secret"`
 	Site                      types.String `tfsdk:"site"`
+	Sites                     types.List   `tfsdk:"sites"`
+	SiteRoleValidation        types.String `tfsdk:"site_role_validation"`
+}
+
+type tableauProviderSiteModel struct {
+	ContentURL types.String `tfsdk:"content_url"`
+	Alias      types.String `tfsdk:"alias"`
 }
 
 func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -135,10 +171,42 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.Sites.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sites"),
+			"Unknown Tableau Sites",
+			"Tableau Sites must be known in order to resolve the site of resources and data sources",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	siteAliases := map[string]string{}
+	if !config.Sites.IsNull() {
+		var sites []tableauProviderSiteModel
+		resp.Diagnostics.Append(config.Sites.ElementsAs(ctx, &sites, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, site := range sites {
+			alias := site.Alias.ValueString()
+			if alias == "" {
+				continue
+			}
+			if _, ok := siteAliases[alias]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("sites"),
+					"Duplicate Tableau Site Alias",
+					"Site alias "+alias+" is declared more than once",
+				)
+				continue
+			}
+			siteAliases[alias] = site.ContentURL.ValueString()
+		}
+	}
+
 	serverURL := os.Getenv("TABLEAU_SERVER_URL")
 	serverVersion := os.Getenv("TABLEAU_SERVER_VERSION")
 	username := os.Getenv("TABLEAU_USERNAME")
@@ -146,6 +214,7 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 	personalAccessTokenName := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_NAME")
 	personalAccessTokenSecret := os.Getenv("TABLEAU_PERSONAL_ACCESS_TOKEN_SECRET")
 	site := os.Getenv("TABLEAU_SITE_NAME")
+	siteRoleValidation := os.Getenv("TABLEAU_SITE_ROLE_VALIDATION")
 
 	if !config.ServerURL.IsNull() {
 		serverURL = config.ServerURL.ValueString()
@@ -175,6 +244,14 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		site = config.Site.ValueString()
 	}
 
+	if !config.SiteRoleValidation.IsNull() {
+		siteRoleValidation = config.SiteRoleValidation.ValueString()
+	}
+
+	if siteRoleValidation == "" {
+		siteRoleValidation = "warn"
+	}
+
 	if serverURL == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("server_url"),
@@ -204,6 +281,14 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if !slices.Contains(siteRoleValidationModes, siteRoleValidation) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("site_role_validation"),
+			"Invalid Tableau Site Role Validation",
+			"Site role validation must be one of "+strings.Join(siteRoleValidationModes, ", ")+", got "+siteRoleValidation,
+		)
+	}
+
 	if password == "" && personalAccessTokenSecret == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
@@ -240,6 +325,9 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	client.SiteRoleValidation = siteRoleValidation
+	client.SiteAliases = siteAliases
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 
@@ -254,16 +342,27 @@ func (p *tableauProvider) DataSources(_ context.Context) []func() datasource.Dat
 		UsersDataSource,
 		ProjectDataSource,
 		ProjectsDataSource,
+		ProjectTreeDataSource,
 		SiteDataSource,
+		SitesDataSource,
 		DatasourceDataSource,
 		DatasourcesDataSource,
+		DatasourceConnectionsDataSource,
+		DatasourcePermissionsDataSource,
+		DatasourceRevisionsDataSource,
 		DefaultPermissionsDataSource,
+		EffectivePermissionsDataSource,
 		ProjectPermissionsDataSource,
+		ViewDataSource,
+		ViewPermissionsDataSource,
+		ViewsDataSource,
 		VirtualConnectionDataSource,
 		VirtualConnectionsDataSource,
 		VirtualConnectionConnectionsDataSource,
+		VirtualConnectionPermissionsDataSource,
 		VirtualConnectionRevisionsDataSource,
 		WorkbookConnectionsDataSource,
+		WorkbookPermissionsDataSource,
 		WorkbooksDataSource,
 		WorkbookRevisionsDataSource,
 	}
@@ -279,10 +378,29 @@ func (p *tableauProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewSiteUserResource,
 		NewSiteGroupResource,
 		NewSiteProjectResource,
+		NewDatabasePermissionResource,
+		NewDataRolePermissionResource,
+		NewDatasourceConnectionResource,
 		NewDatasourcePermissionResource,
+		NewDatasourceRevisionResource,
+		NewFlowPermissionResource,
+		NewLensPermissionResource,
+		NewMetricPermissionResource,
+		NewPermissionsResource,
+		NewProjectDefaultPermissionsResource,
 		NewProjectPermissionResource,
+		NewTablePermissionResource,
 		NewViewPermissionResource,
 		NewVirtualConnectionPermissionResource,
+		NewWorkbookConnectionResource,
 		NewWorkbookPermissionResource,
+		NewWorkbookRevisionResource,
+		NewWorkbookSettingsResource,
+	}
+}
+
+func (p *tableauProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCapabilitiesFunction,
 	}
 }
@@ -8,10 +8,31 @@ import (
 )
 
 type Site struct {
-	ID                string `json:"id,omitempty"`
-	Name              string `json:"name,omitempty"`
-	ContentURL        string `json:"contentUrl,omitempty"`
-	RecycleBinEnabled *bool  `json:"recycleBinEnabled,omitempty"`
+	ID                        string `json:"id,omitempty"`
+	Name                      string `json:"name,omitempty"`
+	ContentURL                string `json:"contentUrl,omitempty"`
+	State                     string `json:"state,omitempty"`
+	AdminMode                 string `json:"adminMode,omitempty"`
+	UserQuota                 string `json:"userQuota,omitempty"`
+	StorageQuota              string `json:"storageQuota,omitempty"`
+	TierCreatorCapacity       string `json:"tierCreatorCapacity,omitempty"`
+	TierExplorerCapacity      string `json:"tierExplorerCapacity,omitempty"`
+	TierViewerCapacity        string `json:"tierViewerCapacity,omitempty"`
+	RevisionHistoryEnabled    *bool  `json:"revisionHistoryEnabled,omitempty"`
+	RevisionLimit             string `json:"revisionLimit,omitempty"`
+	DisableSubscriptions      *bool  `json:"disableSubscriptions,omitempty"`
+	FlowsEnabled              *bool  `json:"flowsEnabled,omitempty"`
+	CommentingEnabled         *bool  `json:"commentingEnabled,omitempty"`
+	DataAlertsEnabled         *bool  `json:"dataAlertsEnabled,omitempty"`
+	CatalogingEnabled         *bool  `json:"catalogingEnabled,omitempty"`
+	ExplainDataEnabled        *bool  `json:"explainDataEnabled,omitempty"`
+	ExtractEncryptionMode     string `json:"extractEncryptionMode,omitempty"`
+	DerivedPermissionsEnabled *bool  `json:"derivedPermissionsEnabled,omitempty"`
+	PersonalSpaceEnabled      *bool  `json:"personalSpaceEnabled,omitempty"`
+	PersonalSpaceStorageQuota string `json:"personalSpaceStorageQuota,omitempty"`
+	GuestAccessEnabled        *bool  `json:"guestAccessEnabled,omitempty"`
+	CacheWarmupEnabled        *bool  `json:"cacheWarmupEnabled,omitempty"`
+	RecycleBinEnabled         *bool  `json:"recycleBinEnabled,omitempty"`
 }
 
 type SiteRequest struct {
@@ -130,13 +151,57 @@ func (c *Client) GetSite(siteID string) (*Site, error) {
 	return nil, fmt.Errorf("did not find site ID %s", siteID)
 }
 
-func (c *Client) CreateSite(name, contentURL string, recycleBinEnabled *bool) (*Site, error) {
+// QuerySite returns every setting of the site with the given ID, which must be the site the client is signed in to.
+// The sites list returned by GetSites and GetSite leaves some settings out.
+func (c *Client) QuerySite(siteID string) (*Site, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/sites/%s", c.BaseUrl, siteID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	siteResponse := SiteResponse{}
+	err = json.Unmarshal(body, &siteResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &siteResponse.Site, nil
+}
+
+// GetSiteByContentURL returns the site with the given content URL, the default site has an empty content URL.
+func (c *Client) GetSiteByContentURL(contentURL string) (*Site, error) {
+	sites, err := c.GetSites()
+	if err != nil {
+		return nil, err
+	}
+	for i, site := range sites {
+		if site.ContentURL == contentURL {
+			return &sites[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find site with content URL %s", contentURL)
+}
 
-	newSite := Site{
-		Name:              name,
-		ContentURL:        contentURL,
-		RecycleBinEnabled: recycleBinEnabled,
+func (c *Client) GetSiteByName(name string) (*Site, error) {
+	sites, err := c.GetSites()
+	if err != nil {
+		return nil, err
+	}
+	for i, site := range sites {
+		if site.Name == name {
+			return &sites[i], nil
+		}
 	}
+	return nil, fmt.Errorf("did not find site named %s", name)
+}
+
+func (c *Client) CreateSite(newSite Site) (*Site, error) {
+
 	siteRequest := SiteRequest{
 		Site: newSite,
 	}
@@ -165,13 +230,9 @@ func (c *Client) CreateSite(name, contentURL string, recycleBinEnabled *bool) (*
 	return &siteResponse.Site, nil
 }
 
-func (c *Client) UpdateSite(siteID, name, contentURL string, recycleBinEnabled *bool) (*Site, error) {
+// UpdateSite sets the settings of the site, settings left empty in the site are not changed.
+func (c *Client) UpdateSite(siteID string, newSite Site) (*Site, error) {
 
-	newSite := Site{
-		Name:              name,
-		ContentURL:        contentURL,
-		RecycleBinEnabled: recycleBinEnabled,
-	}
 	siteRequest := SiteRequest{
 		Site: newSite,
 	}
@@ -0,0 +1,186 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ResolveSiteID returns the ID of the site named by an alias declared in the provider sites, a content URL or an ID.
+// An empty site names the provider site.
+func (c *Client) ResolveSiteID(site string) (string, error) {
+	if site == "" || site == c.SiteID {
+		return c.SiteID, nil
+	}
+	if contentURL, ok := c.SiteAliases[site]; ok {
+		site = contentURL
+		if site == c.SiteContentURL {
+			return c.SiteID, nil
+		}
+	}
+
+	c.siteClientsLock.Lock()
+	defer c.siteClientsLock.Unlock()
+	if siteID, ok := findSiteID(c.sites, site); ok {
+		return siteID, nil
+	}
+	// The sites are listed again on a miss, the site may have been created since they were last listed
+	sites, err := c.GetSites()
+	if err != nil {
+		return "", err
+	}
+	c.sites = sites
+	if siteID, ok := findSiteID(c.sites, site); ok {
+		return siteID, nil
+	}
+	return "", fmt.Errorf("site %s is not an alias, content URL or ID of a site", site)
+}
+
+// findSiteID returns the ID of the site with the given content URL or ID.
+func findSiteID(sites []Site, site string) (string, bool) {
+	for _, s := range sites {
+		if s.ID == site || s.ContentURL == site {
+			return s.ID, true
+		}
+	}
+	return "", false
+}
+
+// ResolveSiteClient returns a client authenticated to the site named by an alias, a content URL or an ID, see ResolveSiteID.
+func (c *Client) ResolveSiteClient(site string) (*Client, error) {
+	siteID, err := c.ResolveSiteID(site)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetSiteClient(siteID)
+}
+
+// GetSiteClient returns a client authenticated to the site with the given ID.
+// The client itself is returned for an empty site ID or its own site, other sites are signed in to once and reused.
+func (c *Client) GetSiteClient(siteID string) (*Client, error) {
+	if siteID == "" || siteID == c.SiteID {
+		return c, nil
+	}
+
+	c.siteClientsLock.Lock()
+	defer c.siteClientsLock.Unlock()
+	if siteClient, ok := c.siteClients[siteID]; ok {
+		return siteClient, nil
+	}
+	siteClient, err := c.NewSiteAuthenticatedClient(siteID)
+	if err != nil {
+		return nil, err
+	}
+	if c.siteClients == nil {
+		c.siteClients = map[string]*Client{}
+	}
+	c.siteClients[siteID] = siteClient
+	return siteClient, nil
+}
+
+// siteAttribute is the site argument of the resources managing content of a site.
+func siteAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Alias, content URL or ID of the site, defaults to the provider site",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// siteDataSourceAttribute is the site argument of the data sources reading content of a site.
+func siteDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Description: "Alias, content URL or ID of the site, defaults to the provider site",
+	}
+}
+
+// getPlanSiteClient returns the client of the planned site for the plan time checks of a site scoped resource.
+// It returns nil while the site is unknown or cannot be signed in to, which skips the checks.
+func getPlanSiteClient(ctx context.Context, client *Client, req resource.ModifyPlanRequest) *Client {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return nil
+	}
+	var site types.String
+	diags := req.Plan.GetAttribute(ctx, path.Root("site"), &site)
+	if diags.HasError() || site.IsUnknown() {
+		return nil
+	}
+	siteClient, err := client.ResolveSiteClient(site.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not sign in to the site of the resource", map[string]any{"site": site.ValueString(), "error": err.Error()})
+		return nil
+	}
+	return siteClient
+}
+
+// ignoreEquivalentSiteChange keeps the resource when its site changes between two names of the same site, for example
+// the site ID written by Read after an import and an alias in the configuration. The new name is stored by an in-place update.
+// The grantee IDs of the single capability permission resources, unknown in such a plan, are kept by resolvePlannedGrantee.
+func ignoreEquivalentSiteChange(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var stateSite, planSite types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("site"), &stateSite)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("site"), &planSite)...)
+	if resp.Diagnostics.HasError() || planSite.IsUnknown() || stateSite.Equal(planSite) {
+		return
+	}
+	if !client.IsSameSite(stateSite.ValueString(), planSite.ValueString()) {
+		return
+	}
+	requiresReplace := path.Paths{}
+	for _, p := range resp.RequiresReplace {
+		if !p.Equal(path.Root("site")) {
+			requiresReplace = append(requiresReplace, p)
+		}
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+// IsSameSite reports whether two aliases, content URLs or IDs name the same site, see ResolveSiteID.
+func (c *Client) IsSameSite(site, otherSite string) bool {
+	siteID, err := c.ResolveSiteID(site)
+	if err != nil {
+		return false
+	}
+	otherSiteID, err := c.ResolveSiteID(otherSite)
+	return err == nil && siteID == otherSiteID
+}
+
+// getClientSiteID returns the site ID of a site client, or an empty ID when it is the provider client.
+func getClientSiteID(client, siteClient *Client) string {
+	if siteClient == client {
+		return ""
+	}
+	return siteClient.SiteID
+}
+
+// getSiteScopedID appends the site ID to the ID of a resource created on another site than the provider site.
+func getSiteScopedID(id, siteID string) string {
+	if siteID == "" {
+		return id
+	}
+	return GetCombinedID(id, siteID)
+}
+
+// splitSiteScopedID splits an ID made of idParts colon separated parts, optionally followed by a site ID.
+func splitSiteScopedID(id string, idParts int) (string, string) {
+	parts := strings.Split(id, ":")
+	if len(parts) <= idParts {
+		return id, ""
+	}
+	return strings.Join(parts[:idParts], ":"), parts[idParts]
+}
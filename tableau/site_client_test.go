@@ -0,0 +1,188 @@
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newSitesTestClient returns a client signed in to the finance site of a server listing the given sites,
+// and a pointer to the number of times the sites were listed.
+func newSitesTestClient(t *testing.T, sites *[]Site) (*Client, *int) {
+	listed := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/3.19/sites" {
+			http.NotFound(w, r)
+			return
+		}
+		listed++
+		_ = json.NewEncoder(w).Encode(SiteListResponse{
+			SitesResponse: SitesResponse{Sites: *sites},
+			Pagination: PaginationDetails{
+				PageNumber:     "1",
+				PageSize:       "100",
+				TotalAvailable: strconv.Itoa(len(*sites)),
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{
+		HTTPClient:     server.Client(),
+		BaseUrl:        server.URL + "/api/3.19",
+		SiteID:         "finance-id",
+		SiteContentURL: "finance",
+		SiteAliases:    map[string]string{"fin": "finance", "mkt": "marketing"},
+	}, &listed
+}
+
+func TestResolveSiteID(t *testing.T) {
+	sites := []Site{
+		{ID: "finance-id", ContentURL: "finance"},
+		{ID: "marketing-id", ContentURL: "marketing"},
+	}
+	client, _ := newSitesTestClient(t, &sites)
+
+	tests := map[string]string{
+		"":             "finance-id",
+		"finance-id":   "finance-id",
+		"fin":          "finance-id",
+		"mkt":          "marketing-id",
+		"marketing":    "marketing-id",
+		"marketing-id": "marketing-id",
+	}
+	for site, expected := range tests {
+		siteID, err := client.ResolveSiteID(site)
+		if err != nil {
+			t.Fatalf("unexpected error resolving %q: %s", site, err)
+		}
+		if siteID != expected {
+			t.Errorf("expected %s for %q, got %s", expected, site, siteID)
+		}
+	}
+
+	if _, err := client.ResolveSiteID("unknown"); err == nil {
+		t.Error("expected an error for an unknown site")
+	}
+}
+
+func TestResolveSiteIDListsSitesAgainOnMiss(t *testing.T) {
+	sites := []Site{
+		{ID: "finance-id", ContentURL: "finance"},
+	}
+	client, listed := newSitesTestClient(t, &sites)
+
+	if _, err := client.ResolveSiteID("finance"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.ResolveSiteID("finance"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *listed != 1 {
+		t.Errorf("expected the sites to be listed once while they are found, got %d", *listed)
+	}
+
+	// A site created after the sites were listed
+	sites = append(sites, Site{ID: "new-id", ContentURL: "new"})
+	for _, site := range []string{"new-id", "new"} {
+		siteID, err := client.ResolveSiteID(site)
+		if err != nil {
+			t.Fatalf("unexpected error resolving %q: %s", site, err)
+		}
+		if siteID != "new-id" {
+			t.Errorf("expected new-id for %q, got %s", site, siteID)
+		}
+	}
+	if *listed != 2 {
+		t.Errorf("expected the sites to be listed again once, got %d", *listed)
+	}
+}
+
+func TestIsSameSite(t *testing.T) {
+	sites := []Site{
+		{ID: "finance-id", ContentURL: "finance"},
+		{ID: "marketing-id", ContentURL: "marketing"},
+	}
+	client, _ := newSitesTestClient(t, &sites)
+
+	tests := []struct {
+		site      string
+		otherSite string
+		expected  bool
+	}{
+		{site: "marketing-id", otherSite: "mkt", expected: true},
+		{site: "marketing", otherSite: "marketing-id", expected: true},
+		{site: "", otherSite: "fin", expected: true},
+		{site: "finance-id", otherSite: "marketing", expected: false},
+		{site: "unknown", otherSite: "unknown", expected: false},
+	}
+	for _, test := range tests {
+		if actual := client.IsSameSite(test.site, test.otherSite); actual != test.expected {
+			t.Errorf("expected %t for %q and %q, got %t", test.expected, test.site, test.otherSite, actual)
+		}
+	}
+}
+
+func TestModifyPlanIgnoresEquivalentSiteChange(t *testing.T) {
+	sites := []Site{
+		{ID: "finance-id", ContentURL: "finance"},
+		{ID: "marketing-id", ContentURL: "marketing"},
+	}
+	client, _ := newSitesTestClient(t, &sites)
+	ctx := context.Background()
+
+	resources := map[string]resource.ResourceWithModifyPlan{
+		"project":               &projectResource{client: client},
+		"group_user":            &groupUserResource{client: client},
+		"site_group":            &siteGroupResource{client: client},
+		"site_user":             &siteUserResource{client: client},
+		"workbook_revision":     &workbookRevisionResource{client: client},
+		"datasource_connection": &datasourceConnectionResource{client: client},
+	}
+	tests := map[string]struct {
+		planSite        string
+		requiresReplace bool
+	}{
+		"alias":      {planSite: "mkt", requiresReplace: false},
+		"other site": {planSite: "finance", requiresReplace: true},
+	}
+	for resourceName, r := range resources {
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		newValue := func(site string) tftypes.Value {
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			attributes["site"] = tftypes.NewValue(tftypes.String, site)
+			return tftypes.NewValue(objectType, attributes)
+		}
+
+		for name, test := range tests {
+			t.Run(resourceName+"/"+name, func(t *testing.T) {
+				req := resource.ModifyPlanRequest{
+					State: tfsdk.State{Schema: schemaResp.Schema, Raw: newValue("marketing-id")},
+					Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue(test.planSite)},
+				}
+				resp := &resource.ModifyPlanResponse{Plan: req.Plan, RequiresReplace: path.Paths{path.Root("site")}}
+
+				r.ModifyPlan(ctx, req, resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				if actual := len(resp.RequiresReplace) > 0; actual != test.requiresReplace {
+					t.Errorf("expected requires replace %t, got %v", test.requiresReplace, resp.RequiresReplace)
+				}
+			})
+		}
+	}
+}
@@ -2,15 +2,19 @@ package tableau
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &siteDataSource{}
-	_ datasource.DataSourceWithConfigure = &siteDataSource{}
+	_ datasource.DataSource                     = &siteDataSource{}
+	_ datasource.DataSourceWithConfigure        = &siteDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &siteDataSource{}
 )
 
 func SiteDataSource() datasource.DataSource {
@@ -22,9 +26,23 @@ type siteDataSource struct {
 }
 
 type siteDataSourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	ContentURL types.String `tfsdk:"content_url"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	ContentURL             types.String `tfsdk:"content_url"`
+	State                  types.String `tfsdk:"state"`
+	AdminMode              types.String `tfsdk:"admin_mode"`
+	UserQuota              types.Int64  `tfsdk:"user_quota"`
+	StorageQuota           types.Int64  `tfsdk:"storage_quota"`
+	TierCreatorCapacity    types.Int64  `tfsdk:"tier_creator_capacity"`
+	TierExplorerCapacity   types.Int64  `tfsdk:"tier_explorer_capacity"`
+	TierViewerCapacity     types.Int64  `tfsdk:"tier_viewer_capacity"`
+	RevisionHistoryEnabled types.Bool   `tfsdk:"revision_history_enabled"`
+	RevisionLimit          types.Int64  `tfsdk:"revision_limit"`
+	DisableSubscriptions   types.Bool   `tfsdk:"disable_subscriptions"`
+	CommentingEnabled      types.Bool   `tfsdk:"commenting_enabled"`
+	GuestAccessEnabled     types.Bool   `tfsdk:"guest_access_enabled"`
+	CacheWarmupEnabled     types.Bool   `tfsdk:"cache_warmup_enabled"`
+	RecycleBinEnabled      types.Bool   `tfsdk:"recycle_bin_enabled"`
 }
 
 func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -32,22 +50,35 @@ func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 }
 
 func (d *siteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := siteDetailsDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the site",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name for the site",
+	}
+	attributes["content_url"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The subdomain name of the site's URL, '' for the default site",
+	}
 	resp.Schema = schema.Schema{
-		Description: "Retrieve site details",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the site",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Name for the site",
-			},
-			"content_url": schema.StringAttribute{
-				Computed:    true,
-				Description: "The subdomain name of the site's URL.",
-			},
-		},
+		Description: "Retrieve site details by ID, name or content URL",
+		Attributes:  attributes,
+	}
+}
+
+func (d *siteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("content_url"),
+		),
 	}
 }
 
@@ -56,7 +87,16 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	site, err := d.client.GetSite(state.ID.ValueString())
+	var site *Site
+	var err error
+	switch {
+	case !state.Name.IsNull():
+		site, err = d.client.GetSiteByName(state.Name.ValueString())
+	case !state.ContentURL.IsNull():
+		site, err = d.client.GetSiteByContentURL(state.ContentURL.ValueString())
+	default:
+		site, err = d.client.GetSite(state.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Site",
@@ -65,9 +105,7 @@ func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	state.ID = types.StringValue(site.ID)
-	state.Name = types.StringValue(site.Name)
-	state.ContentURL = types.StringValue(site.ContentURL)
+	state = getSiteDataSourceModel(site)
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -83,3 +121,107 @@ func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 
 	d.client = req.ProviderData.(*Client)
 }
+
+// siteDetailsDataSourceAttributes are the computed attributes describing a site, shared by tableau_site and tableau_sites.
+func siteDetailsDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the site",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name for the site",
+		},
+		"content_url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The subdomain name of the site's URL, '' for the default site",
+		},
+		"state": schema.StringAttribute{
+			Computed:    true,
+			Description: "State of the site, Active or Suspended",
+		},
+		"admin_mode": schema.StringAttribute{
+			Computed:    true,
+			Description: "Whether site administrators manage users, ContentAndUsers or ContentOnly",
+		},
+		"user_quota": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum number of users of the site",
+		},
+		"storage_quota": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum storage of the site in megabytes",
+		},
+		"tier_creator_capacity": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum number of Creator users of the site",
+		},
+		"tier_explorer_capacity": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum number of Explorer users of the site",
+		},
+		"tier_viewer_capacity": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Maximum number of Viewer users of the site",
+		},
+		"revision_history_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the site keeps revisions of workbooks and datasources",
+		},
+		"revision_limit": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of revisions kept of workbooks and datasources, -1 for unlimited",
+		},
+		"disable_subscriptions": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether users are prevented from subscribing to views",
+		},
+		"commenting_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether users can comment on views",
+		},
+		"guest_access_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether guest users can access the site",
+		},
+		"cache_warmup_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the cache of views is warmed up after their extracts refresh",
+		},
+		"recycle_bin_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether deleted content is kept in the recycle bin",
+		},
+	}
+}
+
+func getSiteDataSourceModel(site *Site) siteDataSourceModel {
+	return siteDataSourceModel{
+		ID:                     types.StringValue(site.ID),
+		Name:                   types.StringValue(site.Name),
+		ContentURL:             types.StringValue(site.ContentURL),
+		State:                  types.StringValue(site.State),
+		AdminMode:              types.StringValue(site.AdminMode),
+		UserQuota:              getSiteInt64Value(site.UserQuota),
+		StorageQuota:           getSiteInt64Value(site.StorageQuota),
+		TierCreatorCapacity:    getSiteInt64Value(site.TierCreatorCapacity),
+		TierExplorerCapacity:   getSiteInt64Value(site.TierExplorerCapacity),
+		TierViewerCapacity:     getSiteInt64Value(site.TierViewerCapacity),
+		RevisionHistoryEnabled: types.BoolPointerValue(site.RevisionHistoryEnabled),
+		RevisionLimit:          getSiteInt64Value(site.RevisionLimit),
+		DisableSubscriptions:   types.BoolPointerValue(site.DisableSubscriptions),
+		CommentingEnabled:      types.BoolPointerValue(site.CommentingEnabled),
+		GuestAccessEnabled:     types.BoolPointerValue(site.GuestAccessEnabled),
+		CacheWarmupEnabled:     types.BoolPointerValue(site.CacheWarmupEnabled),
+		RecycleBinEnabled:      types.BoolPointerValue(site.RecycleBinEnabled),
+	}
+}
+
+// getSiteInt64Value converts a numeric site setting, which the API returns as a string and omits when unset.
+func getSiteInt64Value(value string) types.Int64 {
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return types.Int64Value(number)
+	}
+	return types.Int64Null()
+}
@@ -15,6 +15,7 @@ var (
 	_ resource.Resource                = &siteGroupResource{}
 	_ resource.ResourceWithConfigure   = &siteGroupResource{}
 	_ resource.ResourceWithImportState = &siteGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &siteGroupResource{}
 )
 
 func NewSiteGroupResource() resource.Resource {
@@ -57,7 +58,7 @@ func (r *siteGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"site": schema.StringAttribute{
 				Optional:    true,
-				Description: "Site ID where the group should be imported (omit for default site)",
+				Description: "Alias, content URL or ID of the site where the group should be imported (omit for default site)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -87,6 +88,10 @@ func (r *siteGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *siteGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *siteGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan siteGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -102,9 +107,8 @@ func (r *siteGroupResource) Create(ctx context.Context, req resource.CreateReque
 		siteClient = r.client
 		siteID = r.client.SiteID
 	} else {
-		siteID = plan.Site.ValueString()
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.ResolveSiteClient(plan.Site.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -112,6 +116,7 @@ func (r *siteGroupResource) Create(ctx context.Context, req resource.CreateReque
 			)
 			return
 		}
+		siteID = siteClient.SiteID
 	}
 
 	domainName := plan.DomainName.ValueString()
@@ -151,7 +156,7 @@ func (r *siteGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -199,10 +204,19 @@ func (r *siteGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *siteGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Site groups cannot be updated. Please delete and recreate the resource.",
-	)
+	var plan siteGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute replaces the resource, only the site can be renamed to an equivalent one
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *siteGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -220,7 +234,7 @@ func (r *siteGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -309,7 +323,7 @@ func (r *siteGroupResource) ImportState(ctx context.Context, req resource.Import
 		targetSiteID = targetSite.ID
 
 		var err2 error
-		siteClient, err2 = r.client.NewSiteAuthenticatedClient(targetSiteID)
+		siteClient, err2 = r.client.GetSiteClient(targetSiteID)
 		if err2 != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +21,7 @@ var (
 	_ resource.Resource                = &siteProjectResource{}
 	_ resource.ResourceWithConfigure   = &siteProjectResource{}
 	_ resource.ResourceWithImportState = &siteProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &siteProjectResource{}
 )
 
 func NewSiteProjectResource() resource.Resource {
@@ -31,14 +33,16 @@ type siteProjectResource struct {
 }
 
 type siteProjectResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Site               types.String `tfsdk:"site"`
-	ParentProjectID    types.String `tfsdk:"parent_project_id"`
-	Description        types.String `tfsdk:"description"`
-	ContentPermissions types.String `tfsdk:"content_permissions"`
-	OwnerID            types.String `tfsdk:"owner_id"`
-	LastUpdated        types.String `tfsdk:"last_updated"`
+	ID                 types.String                  `tfsdk:"id"`
+	Name               types.String                  `tfsdk:"name"`
+	Site               types.String                  `tfsdk:"site"`
+	ParentProjectID    types.String                  `tfsdk:"parent_project_id"`
+	Description        types.String                  `tfsdk:"description"`
+	ContentPermissions types.String                  `tfsdk:"content_permissions"`
+	OwnerID            types.String                  `tfsdk:"owner_id"`
+	DeletionProtection types.Bool                    `tfsdk:"deletion_protection"`
+	ContentOnDestroy   *projectContentOnDestroyModel `tfsdk:"content_on_destroy"`
+	LastUpdated        types.String                  `tfsdk:"last_updated"`
 }
 
 func (r *siteProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,7 +65,7 @@ func (r *siteProjectResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"site": schema.StringAttribute{
 				Optional:    true,
-				Description: "Site ID where the project should be created (omit for default site)",
+				Description: "Alias, content URL or ID of the site where the project should be created (omit for default site)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -95,6 +99,13 @@ func (r *siteProjectResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"content_on_destroy": projectContentOnDestroyAttribute(),
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether Terraform is prevented from destroying the project, set it to false and apply before destroying the project",
+			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -102,6 +113,10 @@ func (r *siteProjectResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *siteProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *siteProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan siteProjectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -117,9 +132,8 @@ func (r *siteProjectResource) Create(ctx context.Context, req resource.CreateReq
 		siteClient = r.client
 		siteID = r.client.SiteID
 	} else {
-		siteID = plan.Site.ValueString()
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.ResolveSiteClient(plan.Site.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -127,6 +141,7 @@ func (r *siteProjectResource) Create(ctx context.Context, req resource.CreateReq
 			)
 			return
 		}
+		siteID = siteClient.SiteID
 	}
 
 	createdProject, err := siteClient.CreateProject(
@@ -170,7 +185,7 @@ func (r *siteProjectResource) Read(ctx context.Context, req resource.ReadRequest
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -193,6 +208,9 @@ func (r *siteProjectResource) Read(ctx context.Context, req resource.ReadRequest
 	state.OwnerID = types.StringValue(project.Owner.ID)
 	state.Description = types.StringValue(project.Description)
 	state.ContentPermissions = types.StringValue(project.ContentPermissions)
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -216,7 +234,7 @@ func (r *siteProjectResource) Update(ctx context.Context, req resource.UpdateReq
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -276,6 +294,14 @@ func (r *siteProjectResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Project",
+			"Could not delete project "+state.Name.ValueString()+", deletion_protection is enabled. Set deletion_protection to false and apply before destroying the project.",
+		)
+		return
+	}
+
 	projectID, siteID := GetIDsFromCombinedID(state.ID.ValueString())
 
 	var siteClient *Client
@@ -283,7 +309,7 @@ func (r *siteProjectResource) Delete(ctx context.Context, req resource.DeleteReq
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -293,6 +319,11 @@ func (r *siteProjectResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 	}
 
+	resp.Diagnostics.Append(handleProjectContentOnDestroy(siteClient, projectID, state.ContentOnDestroy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := siteClient.DeleteProject(projectID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -372,7 +403,7 @@ func (r *siteProjectResource) ImportState(ctx context.Context, req resource.Impo
 		targetSiteID = targetSite.ID
 
 		var err2 error
-		siteClient, err2 = r.client.NewSiteAuthenticatedClient(targetSiteID)
+		siteClient, err2 = r.client.GetSiteClient(targetSiteID)
 		if err2 != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
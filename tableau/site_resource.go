@@ -2,13 +2,24 @@ package tableau
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -16,6 +27,7 @@ var (
 	_ resource.Resource                = &siteResource{}
 	_ resource.ResourceWithConfigure   = &siteResource{}
 	_ resource.ResourceWithImportState = &siteResource{}
+	_ resource.ResourceWithModifyPlan  = &siteResource{}
 )
 
 func NewSiteResource() resource.Resource {
@@ -27,11 +39,35 @@ type siteResource struct {
 }
 
 type siteResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	ContentURL        types.String `tfsdk:"content_url"`
-	RecycleBinEnabled types.Bool   `tfsdk:"recycle_bin_enabled"`
-	LastUpdated       types.String `tfsdk:"last_updated"`
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	ContentURL                types.String `tfsdk:"content_url"`
+	RecycleBinEnabled         types.Bool   `tfsdk:"recycle_bin_enabled"`
+	AdminMode                 types.String `tfsdk:"admin_mode"`
+	UserQuota                 types.Int64  `tfsdk:"user_quota"`
+	StorageQuota              types.Int64  `tfsdk:"storage_quota"`
+	State                     types.String `tfsdk:"state"`
+	RevisionHistoryEnabled    types.Bool   `tfsdk:"revision_history_enabled"`
+	RevisionLimit             types.Int64  `tfsdk:"revision_limit"`
+	DisableSubscriptions      types.Bool   `tfsdk:"disable_subscriptions"`
+	FlowsEnabled              types.Bool   `tfsdk:"flows_enabled"`
+	CommentingEnabled         types.Bool   `tfsdk:"commenting_enabled"`
+	DataAlertsEnabled         types.Bool   `tfsdk:"data_alerts_enabled"`
+	CatalogingEnabled         types.Bool   `tfsdk:"cataloging_enabled"`
+	ExplainDataEnabled        types.Bool   `tfsdk:"explain_data_enabled"`
+	ExtractEncryptionMode     types.String `tfsdk:"extract_encryption_mode"`
+	DerivedPermissionsEnabled types.Bool   `tfsdk:"derived_permissions_enabled"`
+	PersonalSpaceEnabled      types.Bool   `tfsdk:"personal_space_enabled"`
+	PersonalSpaceStorageQuota types.Int64  `tfsdk:"personal_space_storage_quota"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy                 types.String `tfsdk:"on_destroy"`
+	BootstrapAdminEnabled     types.Bool   `tfsdk:"bootstrap_admin_enabled"`
+	BootstrapAdminName        types.String `tfsdk:"bootstrap_admin_name"`
+	BootstrapAdminSiteRole    types.String `tfsdk:"bootstrap_admin_site_role"`
+	BootstrapAdminGroup       types.String `tfsdk:"bootstrap_admin_group"`
+	BootstrapAdminUserID      types.String `tfsdk:"bootstrap_admin_user_id"`
+	BootstrapAdminGroupID     types.String `tfsdk:"bootstrap_admin_group_id"`
+	LastUpdated               types.String `tfsdk:"last_updated"`
 }
 
 func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,6 +97,223 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Description: "Enable or disable the recycle bin for the site",
 			},
+			"admin_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether site administrators manage users and content, ContentAndUsers or ContentOnly",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"ContentAndUsers",
+						"ContentOnly",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_quota": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of users of the site, -1 for no limit",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage_quota": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum storage of the site in megabytes",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "State of the site, Active or Suspended",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"Active",
+						"Suspended",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision_history_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the site keeps revisions of workbooks and datasources",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revision_limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of revisions kept of workbooks and datasources, between 2 and 10000 or -1 for unlimited",
+				Validators: []validator.Int64{
+					int64validator.Any(int64validator.Between(2, 10000), int64validator.OneOf(-1)),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_subscriptions": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users are prevented from subscribing to views",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flows_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users can create, publish and run flows",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commenting_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users can comment on views",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_alerts_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users can create data driven alerts",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cataloging_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Tableau Catalog is enabled for the site",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"explain_data_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Explain Data is available on views",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"extract_encryption_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Encryption of extracts at rest, one of enforced/enabled/disabled",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"enforced",
+						"enabled",
+						"disabled",
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"derived_permissions_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users can run web authoring and data questions with the permissions of the workbook owner",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"personal_space_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether users get a personal space to save their work",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"personal_space_storage_quota": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum storage of each personal space in megabytes",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether Terraform is prevented from destroying the site, set it to false and apply before destroying the site",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("delete"),
+				Description: "What destroying the site does, delete deletes the site and its content, suspend sets the site state to Suspended and keeps its content",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"delete",
+						"suspend",
+					}...),
+				},
+			},
+			"bootstrap_admin_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether a user is added to the created site as administrator, only applied when the site is created",
+			},
+			"bootstrap_admin_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the user of the provider site added to the created site as administrator, defaults to the provider user, only applied when the site is created",
+			},
+			"bootstrap_admin_site_role": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SiteAdministratorCreator"),
+				Description: "Site role of the bootstrap administrator and minimum site role of the bootstrap group, SiteAdministratorCreator or SiteAdministratorExplorer, only applied when the site is created",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"SiteAdministratorCreator",
+						"SiteAdministratorExplorer",
+					}...),
+				},
+			},
+			"bootstrap_admin_group": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a group created on the site with the bootstrap administrator site role as minimum site role, only applied when the site is created",
+			},
+			"bootstrap_admin_user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the bootstrap administrator on the site, removed from the site when it is destroyed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bootstrap_admin_group_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the bootstrap group on the site, removed from the site when it is destroyed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -68,6 +321,41 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ModifyPlan rejects changes to the bootstrap settings of an existing site, they are only applied when the site is created.
+// Settings the state has no value for, for example after an import, can be set freely since nothing was applied with them.
+func (r *siteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state, plan siteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bootstrapSettings := []struct {
+		name  string
+		state attr.Value
+		plan  attr.Value
+	}{
+		{name: "bootstrap_admin_enabled", state: state.BootstrapAdminEnabled, plan: plan.BootstrapAdminEnabled},
+		{name: "bootstrap_admin_name", state: state.BootstrapAdminName, plan: plan.BootstrapAdminName},
+		{name: "bootstrap_admin_site_role", state: state.BootstrapAdminSiteRole, plan: plan.BootstrapAdminSiteRole},
+		{name: "bootstrap_admin_group", state: state.BootstrapAdminGroup, plan: plan.BootstrapAdminGroup},
+	}
+	for _, setting := range bootstrapSettings {
+		if setting.state.IsNull() || setting.plan.IsUnknown() || setting.state.Equal(setting.plan) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(setting.name),
+			"Cannot change bootstrap setting of an existing site",
+			setting.name+" is only applied when the site is created, restore its value "+setting.state.String()+" or recreate the site",
+		)
+	}
+}
+
 func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan siteResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -85,7 +373,11 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		recycleBinEnabled = &f
 	}
 
-	createdSite, err := r.client.CreateSite(plan.Name.ValueString(), plan.ContentURL.ValueString(), recycleBinEnabled)
+	newSite := getSiteSettings(plan)
+	newSite.RecycleBinEnabled = recycleBinEnabled
+	// A site can only be suspended after it is created
+	newSite.State = ""
+	createdSite, err := r.client.CreateSite(newSite)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating site",
@@ -94,7 +386,15 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Add provider user to the created site
+	// Track the created site even when the steps below fail, the next apply then replaces it
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), createdSite.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), plan.DeletionProtection)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), plan.OnDestroy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enroll the bootstrap administrator in the created site
 	siteClient, err := r.client.NewSiteAuthenticatedClient(createdSite.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -104,22 +404,23 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	currentUser, err := r.client.GetCurrentUser()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting current user",
-			"Could not get current user: "+err.Error(),
-		)
+	resp.Diagnostics.Append(r.enrollBootstrapAdmin(siteClient, &plan)...)
+	// Record what was enrolled, even before a failure, so that it is removed on destroy
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_admin_user_id"), plan.BootstrapAdminUserID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_admin_group_id"), plan.BootstrapAdminGroupID)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err = siteClient.CreateUser(currentUser.Email, currentUser.Name, currentUser.FullName, "SiteAdministratorCreator", currentUser.AuthSetting)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error adding user to site",
-			"Could not add provider user to site: "+err.Error(),
-		)
-		return
+	if plan.State.ValueString() == "Suspended" {
+		createdSite, err = siteClient.UpdateSite(createdSite.ID, Site{State: "Suspended"})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error suspending site",
+				"Could not suspend site, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	plan.ID = types.StringValue(createdSite.ID)
@@ -128,6 +429,7 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 	} else {
 		plan.RecycleBinEnabled = types.BoolValue(false)
 	}
+	setSiteSettings(&plan, createdSite, false)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -150,6 +452,14 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	site, err = r.querySite(site.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Site",
+			"Could not read Tableau site ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
 
 	state.ID = types.StringValue(site.ID)
 	state.Name = types.StringValue(site.Name)
@@ -159,6 +469,13 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	} else {
 		state.RecycleBinEnabled = types.BoolValue(false)
 	}
+	setSiteSettings(&state, site, true)
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue("delete")
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -181,7 +498,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		recycleBinEnabled = &v
 	}
 
-	// Authenticate to the target site before updating
+	// Authenticate to the target site before updating, server administrators can also sign in to a suspended site to reactivate it
 	siteClient, err := r.client.NewSiteAuthenticatedClient(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -191,7 +508,9 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	_, err = siteClient.UpdateSite(plan.ID.ValueString(), plan.Name.ValueString(), plan.ContentURL.ValueString(), recycleBinEnabled)
+	newSite := getSiteSettings(plan)
+	newSite.RecycleBinEnabled = recycleBinEnabled
+	_, err = siteClient.UpdateSite(plan.ID.ValueString(), newSite)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau Site",
@@ -200,7 +519,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	updatedSite, err := r.client.GetSite(plan.ID.ValueString())
+	updatedSite, err := siteClient.QuerySite(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Site",
@@ -216,6 +535,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	} else {
 		plan.RecycleBinEnabled = types.BoolValue(false)
 	}
+	setSiteSettings(&plan, updatedSite, true)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -233,6 +553,14 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tableau Site",
+			"Could not delete site "+state.Name.ValueString()+", deletion_protection is enabled. Set deletion_protection to false and apply before destroying the site.",
+		)
+		return
+	}
+
 	// Authenticate to the target site before deletion
 	siteClient, err := r.client.NewSiteAuthenticatedClient(state.ID.ValueString())
 	if err != nil {
@@ -243,6 +571,22 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.OnDestroy.ValueString() == "suspend" {
+		// A deleted site takes its users and groups with it, they are only removed from a site that is kept
+		resp.Diagnostics.Append(removeBootstrapAdmin(siteClient, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, err = siteClient.UpdateSite(state.ID.ValueString(), Site{State: "Suspended"})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Suspending Tableau Site",
+				"Could not suspend site, unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
 	err = siteClient.DeleteSite(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -305,4 +649,220 @@ func (r *siteResource) ImportState(ctx context.Context, req resource.ImportState
 		recycleBinValue = *targetSite.RecycleBinEnabled
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("recycle_bin_enabled"), recycleBinValue)...)
+	// Imported sites keep the bootstrap defaults, nothing was enrolled by Terraform so nothing is removed on destroy
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_admin_enabled"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bootstrap_admin_site_role"), "SiteAdministratorCreator")...)
+}
+
+// enrollBootstrapAdmin adds the bootstrap administrator and group of the plan to the created site and records their IDs.
+func (r *siteResource) enrollBootstrapAdmin(siteClient *Client, plan *siteResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.BootstrapAdminUserID = types.StringNull()
+	plan.BootstrapAdminGroupID = types.StringNull()
+	siteRole := plan.BootstrapAdminSiteRole.ValueString()
+
+	if plan.BootstrapAdminEnabled.ValueBool() {
+		admin, err := r.getBootstrapAdmin(plan.BootstrapAdminName.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Error getting bootstrap administrator",
+				"Could not get bootstrap administrator: "+err.Error(),
+			)
+			return diags
+		}
+		user, err := siteClient.CreateUser(admin.Email, admin.Name, admin.FullName, siteRole, admin.AuthSetting)
+		if err != nil {
+			diags.AddError(
+				"Error adding user to site",
+				"Could not add bootstrap administrator "+admin.Name+" to site: "+err.Error(),
+			)
+			return diags
+		}
+		plan.BootstrapAdminUserID = types.StringValue(user.ID)
+	}
+
+	if !plan.BootstrapAdminGroup.IsNull() {
+		group, err := siteClient.CreateGroup(plan.BootstrapAdminGroup.ValueString(), siteRole)
+		if err != nil {
+			diags.AddError(
+				"Error adding group to site",
+				"Could not add bootstrap group "+plan.BootstrapAdminGroup.ValueString()+" to site: "+err.Error(),
+			)
+			return diags
+		}
+		plan.BootstrapAdminGroupID = types.StringValue(group.ID)
+	}
+	return diags
+}
+
+// getBootstrapAdmin returns the provider user, or the user of the provider site with the given name.
+func (r *siteResource) getBootstrapAdmin(name string) (*User, error) {
+	if name == "" {
+		return r.client.GetCurrentUser()
+	}
+	users, err := r.client.GetUsers()
+	if err != nil {
+		return nil, err
+	}
+	for i, user := range users {
+		if user.Name == name {
+			return &users[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find user %s on the provider site, the bootstrap administrator is added with the email and authentication of a user of the provider site", name)
+}
+
+// removeBootstrapAdmin removes the bootstrap group and administrator enrolled when the site was created.
+// The provider user is kept, removing it would end the session of the provider.
+func removeBootstrapAdmin(siteClient *Client, state siteResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if groupID := state.BootstrapAdminGroupID.ValueString(); groupID != "" {
+		err := siteClient.DeleteGroup(groupID)
+		if err != nil {
+			diags.AddError(
+				"Error removing group from site",
+				"Could not remove bootstrap group from site: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	if userID := state.BootstrapAdminUserID.ValueString(); userID != "" {
+		user, err := siteClient.GetUser(userID)
+		if IsNotFound(err) {
+			// The administrator was already removed from the site
+			return diags
+		}
+		if err != nil {
+			diags.AddError(
+				"Error reading user",
+				"Could not read bootstrap administrator "+userID+": "+err.Error(),
+			)
+			return diags
+		}
+		if user.Name == siteClient.Username {
+			return diags
+		}
+		err = siteClient.DeleteUser(userID)
+		if err != nil {
+			diags.AddError(
+				"Error removing user from site",
+				"Could not remove bootstrap administrator from site: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+// getSiteSettings returns the site settings of the plan, settings that are not configured are left empty and unchanged.
+func getSiteSettings(plan siteResourceModel) Site {
+	return Site{
+		Name:                      plan.Name.ValueString(),
+		ContentURL:                plan.ContentURL.ValueString(),
+		AdminMode:                 plan.AdminMode.ValueString(),
+		UserQuota:                 getSiteSettingString(plan.UserQuota),
+		StorageQuota:              getSiteSettingString(plan.StorageQuota),
+		State:                     plan.State.ValueString(),
+		RevisionHistoryEnabled:    getSiteSettingBool(plan.RevisionHistoryEnabled),
+		RevisionLimit:             getSiteSettingString(plan.RevisionLimit),
+		DisableSubscriptions:      getSiteSettingBool(plan.DisableSubscriptions),
+		FlowsEnabled:              getSiteSettingBool(plan.FlowsEnabled),
+		CommentingEnabled:         getSiteSettingBool(plan.CommentingEnabled),
+		DataAlertsEnabled:         getSiteSettingBool(plan.DataAlertsEnabled),
+		CatalogingEnabled:         getSiteSettingBool(plan.CatalogingEnabled),
+		ExplainDataEnabled:        getSiteSettingBool(plan.ExplainDataEnabled),
+		ExtractEncryptionMode:     plan.ExtractEncryptionMode.ValueString(),
+		DerivedPermissionsEnabled: getSiteSettingBool(plan.DerivedPermissionsEnabled),
+		PersonalSpaceEnabled:      getSiteSettingBool(plan.PersonalSpaceEnabled),
+		PersonalSpaceStorageQuota: getSiteSettingString(plan.PersonalSpaceStorageQuota),
+	}
+}
+
+// querySite reads every setting of the site with Query Site, signed in to the site.
+// Server administrators can sign in to suspended sites, so their settings are read the same way.
+func (r *siteResource) querySite(siteID string) (*Site, error) {
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		return nil, err
+	}
+	return siteClient.QuerySite(siteID)
+}
+
+// setSiteSettings copies the settings returned by the API into the model.
+// When the site was queried, settings it does not return are not set on the site and become null.
+// Otherwise they keep their value, or become null when they are unknown.
+func setSiteSettings(model *siteResourceModel, site *Site, queried bool) {
+	if queried {
+		// Unknown settings become null below when the site does not return them
+		model.AdminMode = types.StringUnknown()
+		model.UserQuota = types.Int64Unknown()
+		model.StorageQuota = types.Int64Unknown()
+		model.State = types.StringUnknown()
+		model.RevisionHistoryEnabled = types.BoolUnknown()
+		model.RevisionLimit = types.Int64Unknown()
+		model.DisableSubscriptions = types.BoolUnknown()
+		model.FlowsEnabled = types.BoolUnknown()
+		model.CommentingEnabled = types.BoolUnknown()
+		model.DataAlertsEnabled = types.BoolUnknown()
+		model.CatalogingEnabled = types.BoolUnknown()
+		model.ExplainDataEnabled = types.BoolUnknown()
+		model.ExtractEncryptionMode = types.StringUnknown()
+		model.DerivedPermissionsEnabled = types.BoolUnknown()
+		model.PersonalSpaceEnabled = types.BoolUnknown()
+		model.PersonalSpaceStorageQuota = types.Int64Unknown()
+	}
+	setSiteStringSetting(&model.AdminMode, site.AdminMode)
+	setSiteInt64Setting(&model.UserQuota, site.UserQuota)
+	setSiteInt64Setting(&model.StorageQuota, site.StorageQuota)
+	setSiteStringSetting(&model.State, site.State)
+	setSiteBoolSetting(&model.RevisionHistoryEnabled, site.RevisionHistoryEnabled)
+	setSiteInt64Setting(&model.RevisionLimit, site.RevisionLimit)
+	setSiteBoolSetting(&model.DisableSubscriptions, site.DisableSubscriptions)
+	setSiteBoolSetting(&model.FlowsEnabled, site.FlowsEnabled)
+	setSiteBoolSetting(&model.CommentingEnabled, site.CommentingEnabled)
+	setSiteBoolSetting(&model.DataAlertsEnabled, site.DataAlertsEnabled)
+	setSiteBoolSetting(&model.CatalogingEnabled, site.CatalogingEnabled)
+	setSiteBoolSetting(&model.ExplainDataEnabled, site.ExplainDataEnabled)
+	setSiteStringSetting(&model.ExtractEncryptionMode, site.ExtractEncryptionMode)
+	setSiteBoolSetting(&model.DerivedPermissionsEnabled, site.DerivedPermissionsEnabled)
+	setSiteBoolSetting(&model.PersonalSpaceEnabled, site.PersonalSpaceEnabled)
+	setSiteInt64Setting(&model.PersonalSpaceStorageQuota, site.PersonalSpaceStorageQuota)
+}
+
+func getSiteSettingString(value types.Int64) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return strconv.FormatInt(value.ValueInt64(), 10)
+}
+
+func getSiteSettingBool(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+func setSiteStringSetting(value *types.String, setting string) {
+	if setting != "" {
+		*value = types.StringValue(setting)
+	} else if value.IsUnknown() {
+		*value = types.StringNull()
+	}
+}
+
+func setSiteInt64Setting(value *types.Int64, setting string) {
+	if number := getSiteInt64Value(setting); !number.IsNull() {
+		*value = number
+	} else if value.IsUnknown() {
+		*value = types.Int64Null()
+	}
+}
+
+func setSiteBoolSetting(value *types.Bool, setting *bool) {
+	if setting != nil {
+		*value = types.BoolValue(*setting)
+	} else if value.IsUnknown() {
+		*value = types.BoolNull()
+	}
 }
@@ -1,9 +1,12 @@
 package tableau
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -29,6 +32,8 @@ resource "tableau_site" "test" {
 					resource.TestCheckResourceAttrSet("tableau_site.test", "content_url"),
 					resource.TestCheckResourceAttr("tableau_site.test", "name", "test"),
 					resource.TestCheckResourceAttr("tableau_site.test", "content_url", "moo"),
+					resource.TestCheckResourceAttr("tableau_site.test", "bootstrap_admin_enabled", "true"),
+					resource.TestCheckResourceAttrSet("tableau_site.test", "bootstrap_admin_user_id"),
 				),
 			},
 			// ImportState testing
@@ -36,7 +41,7 @@ resource "tableau_site" "test" {
 				ResourceName:            "tableau_site.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"last_updated"},
+				ImportStateVerifyIgnore: []string{"last_updated", "bootstrap_admin_user_id"},
 			},
 			// Update and Read testing
 			{
@@ -44,6 +49,10 @@ resource "tableau_site" "test" {
 resource "tableau_site" "test" {
   name = "test_new"
   content_url = "moo_new"
+  admin_mode = "ContentOnly"
+  revision_history_enabled = true
+  revision_limit = 10
+  commenting_enabled = false
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -53,9 +62,98 @@ resource "tableau_site" "test" {
 					resource.TestCheckResourceAttrSet("tableau_site.test", "content_url"),
 					resource.TestCheckResourceAttr("tableau_site.test", "name", "test_new"),
 					resource.TestCheckResourceAttr("tableau_site.test", "content_url", "moo_new"),
+					resource.TestCheckResourceAttr("tableau_site.test", "admin_mode", "ContentOnly"),
+					resource.TestCheckResourceAttr("tableau_site.test", "revision_limit", "10"),
+					resource.TestCheckResourceAttr("tableau_site.test", "commenting_enabled", "false"),
+				),
+			},
+			// Suspend the site, then update it back to Active
+			{
+				Config: providerConfig + `
+resource "tableau_site" "test" {
+  name = "test_new"
+  content_url = "moo_new"
+  state = "Suspended"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_site.test", "state", "Suspended"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "tableau_site" "test" {
+  name = "test_new"
+  content_url = "moo_new"
+  state = "Active"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_site.test", "state", "Active"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestSetSiteSettings(t *testing.T) {
+	enabled := true
+	site := &Site{AdminMode: "ContentOnly", UserQuota: "100", FlowsEnabled: &enabled}
+
+	listed := siteResourceModel{
+		AdminMode:         types.StringValue("ContentAndUsers"),
+		StorageQuota:      types.Int64Value(500),
+		CommentingEnabled: types.BoolValue(false),
+	}
+	setSiteSettings(&listed, site, false)
+	if listed.AdminMode.ValueString() != "ContentOnly" || listed.UserQuota.ValueInt64() != 100 || !listed.FlowsEnabled.ValueBool() {
+		t.Errorf("expected the returned settings to be set, got %v, %v, %v", listed.AdminMode, listed.UserQuota, listed.FlowsEnabled)
+	}
+	if listed.StorageQuota.ValueInt64() != 500 || listed.CommentingEnabled.IsNull() {
+		t.Errorf("expected the settings missing from the sites list to keep their value, got %v, %v", listed.StorageQuota, listed.CommentingEnabled)
+	}
+
+	queried := siteResourceModel{
+		StorageQuota:      types.Int64Value(500),
+		CommentingEnabled: types.BoolValue(false),
+	}
+	setSiteSettings(&queried, site, true)
+	if !queried.StorageQuota.IsNull() || !queried.CommentingEnabled.IsNull() {
+		t.Errorf("expected the settings missing from the queried site to be null, got %v, %v", queried.StorageQuota, queried.CommentingEnabled)
+	}
+}
+
+func TestRemoveBootstrapAdmin(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		expectError bool
+	}{
+		"already removed": {status: http.StatusNotFound, expectError: false},
+		"server error":    {status: http.StatusInternalServerError, expectError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			client := &Client{
+				HTTPClient: server.Client(),
+				ApiUrl:     server.URL + "/api/3.19/sites/site-id",
+			}
+			state := siteResourceModel{
+				BootstrapAdminUserID:  types.StringValue("user-id"),
+				BootstrapAdminGroupID: types.StringNull(),
+			}
+			diags := removeBootstrapAdmin(client, state)
+			if diags.HasError() != test.expectError {
+				t.Errorf("expected error %t, got %v", test.expectError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package tableau
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var siteRoleValidationModes = []string{
+	"enforce",
+	"off",
+	"warn",
+}
+
+// validateGrantedSiteRoles reports allowed capabilities that the site role of their grantee cannot use:
+// the site role of a user, or the minimum site role of a group. Deny grants are always valid.
+func validateGrantedSiteRoles(ctx context.Context, client *Client, granted []GrantedCapability, attributePath path.Path, diags *diag.Diagnostics) {
+	if client == nil || client.SiteRoleValidation == "off" {
+		return
+	}
+
+	siteRoles := map[string]string{}
+	for _, g := range granted {
+		if g.CapabilityMode != "Allow" {
+			continue
+		}
+		key := g.EntityType + "/" + g.EntityID
+		siteRole, ok := siteRoles[key]
+		if !ok {
+			var err error
+			siteRole, err = getGranteeSiteRole(client, g.EntityType, g.EntityID)
+			if err != nil {
+				// The grantee may not exist yet, apply reports the actual error
+				tflog.Debug(ctx, "Could not look up the site role of the grantee", map[string]any{"entity_type": g.EntityType, "entity_id": g.EntityID, "error": err.Error()})
+			}
+			siteRoles[key] = siteRole
+		}
+		if siteRole == "" || SiteRoleAllowsCapability(siteRole, g.CapabilityName) {
+			continue
+		}
+
+		grantee := "user"
+		if g.EntityType == "groups" {
+			grantee = "group with minimum site role"
+		}
+		summary := "Capability Not Allowed by Site Role"
+		detail := fmt.Sprintf("Capability %s is granted to %s %s, but the %s site role cannot use it, so the grant has no effect. "+
+			"Set site_role_validation of the provider to off to silence this check.", g.CapabilityName, grantee, g.EntityID, siteRole)
+		if client.SiteRoleValidation == "enforce" {
+			diags.AddAttributeError(attributePath, summary, detail)
+		} else {
+			diags.AddAttributeWarning(attributePath, summary, detail)
+		}
+	}
+}
+
+func getGranteeSiteRole(client *Client, entityType, entityID string) (string, error) {
+	if entityType == "users" {
+		user, err := client.GetUser(entityID)
+		if err != nil {
+			return "", err
+		}
+		return user.SiteRole, nil
+	}
+	group, err := client.GetGroup(entityID)
+	if err != nil {
+		return "", err
+	}
+	return group.MinimumSiteRole, nil
+}
+
+// validatePermissionSiteRole checks the grant planned by a single capability permission resource.
+func validatePermissionSiteRole(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var userID, groupID, capabilityName, capabilityMode types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("user_id"), &userID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("group_id"), &groupID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("capability_name"), &capabilityName)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("capability_mode"), &capabilityMode)...)
+	if resp.Diagnostics.HasError() || userID.IsUnknown() || groupID.IsUnknown() || capabilityName.IsUnknown() || capabilityMode.IsUnknown() {
+		return
+	}
+
+	granted := GrantedCapability{
+		EntityType:     "users",
+		EntityID:       userID.ValueString(),
+		CapabilityName: capabilityName.ValueString(),
+		CapabilityMode: capabilityMode.ValueString(),
+	}
+	if granted.EntityID == "" {
+		granted.EntityType = "groups"
+		granted.EntityID = groupID.ValueString()
+	}
+	if granted.EntityID == "" {
+		return
+	}
+	validateGrantedSiteRoles(ctx, client, []GrantedCapability{granted}, path.Root("capability_name"), &resp.Diagnostics)
+}
+
+// validatePermissionsSiteRoles checks the grants planned by an authoritative permissions resource.
+func validatePermissionsSiteRoles(ctx context.Context, client *Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var granteeCapabilities types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("grantee_capabilities"), &granteeCapabilities)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	granted, diags := getKnownGrantedCapabilities(ctx, granteeCapabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateGrantedSiteRoles(ctx, client, granted, path.Root("grantee_capabilities"), &resp.Diagnostics)
+}
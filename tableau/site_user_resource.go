@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &siteUserResource{}
 	_ resource.ResourceWithConfigure   = &siteUserResource{}
 	_ resource.ResourceWithImportState = &siteUserResource{}
+	_ resource.ResourceWithModifyPlan  = &siteUserResource{}
 )
 
 func NewSiteUserResource() resource.Resource {
@@ -57,7 +58,7 @@ func (r *siteUserResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"site": schema.StringAttribute{
 				Optional:    true,
-				Description: "Site ID where the user should be added (omit for default site)",
+				Description: "Alias, content URL or ID of the site where the user should be added (omit for default site)",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -87,6 +88,10 @@ func (r *siteUserResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *siteUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *siteUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan siteUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -111,9 +116,8 @@ func (r *siteUserResource) Create(ctx context.Context, req resource.CreateReques
 		siteClient = r.client
 		siteID = r.client.SiteID
 	} else {
-		siteID = plan.Site.ValueString()
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.ResolveSiteClient(plan.Site.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -121,6 +125,7 @@ func (r *siteUserResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
+		siteID = siteClient.SiteID
 	}
 
 	// Handle ServerAdministrator role special case
@@ -182,7 +187,7 @@ func (r *siteUserResource) Read(ctx context.Context, req resource.ReadRequest, r
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -237,7 +242,7 @@ func (r *siteUserResource) Update(ctx context.Context, req resource.UpdateReques
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
@@ -330,7 +335,7 @@ func (r *siteUserResource) Delete(ctx context.Context, req resource.DeleteReques
 		siteClient = r.client
 	} else {
 		var err error
-		siteClient, err = r.client.NewSiteAuthenticatedClient(siteID)
+		siteClient, err = r.client.GetSiteClient(siteID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error creating site client",
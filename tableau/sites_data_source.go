@@ -0,0 +1,86 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &sitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sitesDataSource{}
+)
+
+func SitesDataSource() datasource.DataSource {
+	return &sitesDataSource{}
+}
+
+type sitesDataSource struct {
+	client *Client
+}
+
+type sitesDataSourceModel struct {
+	ID    types.String          `tfsdk:"id"`
+	Sites []siteDataSourceModel `tfsdk:"sites"`
+}
+
+func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve details of every site the provider credentials can see",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the list of sites",
+			},
+			"sites": schema.ListNestedAttribute{
+				Description: "List of sites and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: siteDetailsDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sitesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	sites, err := d.client.GetSites()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Sites",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Sites = []siteDataSourceModel{}
+	for i := range sites {
+		state.Sites = append(state.Sites, getSiteDataSourceModel(&sites[i]))
+	}
+
+	state.ID = types.StringValue("allSites")
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
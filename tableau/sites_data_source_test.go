@@ -0,0 +1,37 @@
+package tableau
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSitesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck: func(err error) error {
+			_, runningServerTests := os.LookupEnv("TF_ACC_SERVER")
+			if !runningServerTests {
+				return nil
+			}
+
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                data "tableau_sites" "test" {
+                }
+                data "tableau_site" "test" {
+                    content_url = data.tableau_sites.test.sites[0].content_url
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_sites.test", "id"),
+					resource.TestCheckResourceAttrSet("data.tableau_sites.test", "sites.#"),
+					resource.TestCheckResourceAttrPair("data.tableau_site.test", "id", "data.tableau_sites.test", "sites.0.id"),
+				),
+			},
+		},
+	})
+}
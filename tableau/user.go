@@ -100,6 +100,52 @@ func (c *Client) GetUser(userID string) (*User, error) {
 	return &userResponse.User, nil
 }
 
+// GetUserGroups lists the groups the user is a member of.
+func (c *Client) GetUserGroups(userID string) ([]Group, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s/groups", c.ApiUrl, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupListResponse := GroupListResponse{}
+	err = json.Unmarshal(body, &groupListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(groupListResponse.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	allGroups := make([]Group, 0, totalAvailable)
+	allGroups = append(allGroups, groupListResponse.GroupsResponse.Groups...)
+
+	for page := pageNumber + 1; page <= totalPageCount; page++ {
+		req, err = http.NewRequest("GET", fmt.Sprintf("%s/users/%s/groups?pageNumber=%d", c.ApiUrl, userID, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err = c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		groupListResponse = GroupListResponse{}
+		err = json.Unmarshal(body, &groupListResponse)
+		if err != nil {
+			return nil, err
+		}
+		allGroups = append(allGroups, groupListResponse.GroupsResponse.Groups...)
+	}
+
+	return allGroups, nil
+}
+
 func (c *Client) CreateUser(email, name, fullName, siteRole, authSetting string) (*User, error) {
 
 	newUser := User{
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +17,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -30,6 +30,7 @@ type userResource struct {
 
 type userResourceModel struct {
 	ID          types.String `tfsdk:"id"`
+	Site        types.String `tfsdk:"site"`
 	Email       types.String `tfsdk:"email"`
 	Name        types.String `tfsdk:"name"`
 	FullName    types.String `tfsdk:"full_name"`
@@ -52,6 +53,7 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"site": siteAttribute(),
 			"email": schema.StringAttribute{
 				Required:    true,
 				Description: "User email",
@@ -101,6 +103,10 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -109,6 +115,15 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	user := User{
 		Email:       plan.Email.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -117,7 +132,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		AuthSetting: plan.AuthSetting.ValueString(),
 	}
 
-	createdUser, err := r.client.CreateUser(user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	createdUser, err := siteClient.CreateUser(user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating user",
@@ -125,7 +140,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
-	_, err = r.client.UpdateUser(createdUser.ID, user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	_, err = siteClient.UpdateUser(createdUser.ID, user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating user during create",
@@ -152,7 +167,16 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	user, err := r.client.GetUser(state.ID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	user, err := siteClient.GetUser(state.ID.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -180,6 +204,15 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
 	user := User{
 		Email:       plan.Email.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -188,7 +221,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		AuthSetting: plan.AuthSetting.ValueString(),
 	}
 
-	_, err := r.client.UpdateUser(plan.ID.ValueString(), user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
+	_, err = siteClient.UpdateUser(plan.ID.ValueString(), user.Email, user.Name, user.FullName, user.SiteRole, user.AuthSetting)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Tableau User",
@@ -197,7 +230,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	updatedUser, err := r.client.GetUser(plan.ID.ValueString())
+	updatedUser, err := siteClient.GetUser(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau User",
@@ -228,7 +261,16 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.DeleteUser(state.ID.ValueString())
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	err = siteClient.DeleteUser(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tableau User",
@@ -247,5 +289,5 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importSiteContentState(ctx, r.client, req, resp, "userID, name or email", (*Client).ResolveUserImportID)
 }
@@ -29,6 +29,7 @@ type usersNestedDataModel struct {
 }
 
 type usersDataSourceModel struct {
+	Site  types.String           `tfsdk:"site"`
 	ID    types.String           `tfsdk:"id"`
 	Users []usersNestedDataModel `tfsdk:"users"`
 }
@@ -41,6 +42,7 @@ func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Retrieve user details as a list of users available to read",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the users",
@@ -78,7 +80,16 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	users, err := d.client.GetUsers()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	users, err := siteClient.GetUsers()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Users",
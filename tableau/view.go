@@ -0,0 +1,208 @@
+package tableau
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type ViewUsage struct {
+	TotalViewCount string `json:"totalViewCount,omitempty"`
+}
+
+type View struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	ContentURL  string `json:"contentUrl,omitempty"`
+	ViewURLName string `json:"viewUrlName,omitempty"`
+	SheetType   string `json:"sheetType,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
+	UpdatedAt   string `json:"updatedAt,omitempty"`
+	Workbook    struct {
+		ID string `json:"id,omitempty"`
+	} `json:"workbook,omitempty"`
+	Owner struct {
+		ID string `json:"id,omitempty"`
+	} `json:"owner,omitempty"`
+	Project struct {
+		ID string `json:"id,omitempty"`
+	} `json:"project,omitempty"`
+	Tags  Tags       `json:"tags,omitempty"`
+	Usage *ViewUsage `json:"usage,omitempty"`
+}
+
+type ViewResponse struct {
+	View View `json:"view"`
+}
+
+type ViewsResponse struct {
+	Views []View `json:"view"`
+}
+
+type ViewListResponse struct {
+	ViewsResponse ViewsResponse     `json:"views"`
+	Pagination    PaginationDetails `json:"pagination"`
+}
+
+// ViewFilter narrows down the views returned for a site, empty fields are ignored.
+type ViewFilter struct {
+	Name         string
+	WorkbookName string
+	ProjectName  string
+	OwnerName    string
+	Tag          string
+}
+
+// matchesViewAttributes reports whether a view has the name and tag of the filter, the filters of its workbook are not checked.
+func (f ViewFilter) matchesViewAttributes(view View) bool {
+	if f.Name != "" && view.Name != f.Name {
+		return false
+	}
+	if f.Tag == "" {
+		return true
+	}
+	for _, tag := range view.Tags.Tags {
+		if tag.Label == f.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (f ViewFilter) expression() string {
+	expressions := []string{}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"name", f.Name},
+		{"workbookName", f.WorkbookName},
+		{"projectName", f.ProjectName},
+		{"ownerName", f.OwnerName},
+		{"tags", f.Tag},
+	} {
+		if field.value != "" {
+			expressions = append(expressions, fmt.Sprintf("%s:eq:%s", field.name, field.value))
+		}
+	}
+	return strings.Join(expressions, ",")
+}
+
+func (c *Client) GetViews(filter ViewFilter, includeUsageStatistics bool) ([]View, error) {
+	query := url.Values{}
+	if expression := filter.expression(); expression != "" {
+		query.Set("filter", expression)
+	}
+	if includeUsageStatistics {
+		query.Set("includeUsageStatistics", "true")
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/views?%s", c.ApiUrl, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	viewListResponse := ViewListResponse{}
+	err = json.Unmarshal(body, &viewListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber, totalPageCount, totalAvailable, err := GetPaginationNumbers(viewListResponse.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	allViews := make([]View, 0, totalAvailable)
+	allViews = append(allViews, viewListResponse.ViewsResponse.Views...)
+
+	for page := pageNumber + 1; page <= totalPageCount; page++ {
+		query.Set("pageNumber", fmt.Sprintf("%d", page))
+		req, err = http.NewRequest("GET", fmt.Sprintf("%s/views?%s", c.ApiUrl, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err = c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		viewListResponse = ViewListResponse{}
+		err = json.Unmarshal(body, &viewListResponse)
+		if err != nil {
+			return nil, err
+		}
+		allViews = append(allViews, viewListResponse.ViewsResponse.Views...)
+	}
+
+	return allViews, nil
+}
+
+func (c *Client) GetView(viewID string) (*View, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/views/%s", c.ApiUrl, viewID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	viewResponse := ViewResponse{}
+	err = json.Unmarshal(body, &viewResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &viewResponse.View, nil
+}
+
+func (c *Client) GetWorkbookViews(workbookID string, includeUsageStatistics bool) ([]View, error) {
+	requestURL := fmt.Sprintf("%s/workbooks/%s/views", c.ApiUrl, workbookID)
+	if includeUsageStatistics {
+		requestURL += "?includeUsageStatistics=true"
+	}
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	viewListResponse := ViewListResponse{}
+	err = json.Unmarshal(body, &viewListResponse)
+	if err != nil {
+		return nil, err
+	}
+	// workbook views are not paginated, the workbook is not repeated on each view either
+	allViews := viewListResponse.ViewsResponse.Views
+	for idx := range allViews {
+		allViews[idx].Workbook.ID = workbookID
+	}
+	return allViews, nil
+}
+
+// GetWorkbookView finds a view of a workbook by its name, content URL or view URL name.
+func (c *Client) GetWorkbookView(workbookID, name, contentURL string) (*View, error) {
+	views, err := c.GetWorkbookViews(workbookID, false)
+	if err != nil {
+		return nil, err
+	}
+	for i, view := range views {
+		if name != "" && view.Name == name {
+			return &views[i], nil
+		}
+		if contentURL != "" && (view.ContentURL == contentURL || view.ViewURLName == contentURL) {
+			return &views[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find view %s%s in workbook ID %s", name, contentURL, workbookID)
+}
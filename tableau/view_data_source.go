@@ -0,0 +1,164 @@
+package tableau
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &viewDataSource{}
+	_ datasource.DataSourceWithConfigure        = &viewDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &viewDataSource{}
+)
+
+func ViewDataSource() datasource.DataSource {
+	return &viewDataSource{}
+}
+
+type viewDataSource struct {
+	client *Client
+}
+
+type viewDataSourceModel struct {
+	Site        types.String `tfsdk:"site"`
+	ID          types.String `tfsdk:"id"`
+	WorkbookID  types.String `tfsdk:"workbook_id"`
+	Name        types.String `tfsdk:"name"`
+	ContentURL  types.String `tfsdk:"content_url"`
+	ViewURLName types.String `tfsdk:"view_url_name"`
+	SheetType   types.String `tfsdk:"sheet_type"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Tags        types.List   `tfsdk:"tags"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *viewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view"
+}
+
+func (d *viewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve view details by workbook and view name or content URL",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the view",
+			},
+			"workbook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the workbook containing the view",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the view",
+			},
+			"content_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Content URL of the view, for example Superstore/sheets/Overview, or its URL name",
+			},
+			"view_url_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL name of the view within its workbook",
+			},
+			"sheet_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Sheet type, one of dashboard/story/view",
+			},
+			"owner_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the view owner",
+			},
+			"project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the view project",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "List of tags on the view",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "View was created at",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "View was updated at",
+			},
+		},
+	}
+}
+
+func (d *viewDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("content_url"),
+		),
+	}
+}
+
+func (d *viewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state viewDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	view, err := siteClient.GetWorkbookView(state.WorkbookID.ValueString(), state.Name.ValueString(), state.ContentURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau View",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(view.ID)
+	state.Name = types.StringValue(view.Name)
+	state.ContentURL = types.StringValue(view.ContentURL)
+	state.ViewURLName = types.StringValue(view.ViewURLName)
+	state.SheetType = types.StringValue(view.SheetType)
+	state.OwnerID = types.StringValue(view.Owner.ID)
+	state.ProjectID = types.StringValue(view.Project.ID)
+	state.CreatedAt = types.StringValue(view.CreatedAt)
+	state.UpdatedAt = types.StringValue(view.UpdatedAt)
+
+	tags := make([]attr.Value, 0, len(view.Tags.Tags))
+	for _, tag := range view.Tags.Tags {
+		tags = append(tags, types.StringValue(tag.Label))
+	}
+	state.Tags, _ = types.ListValue(types.StringType, tags)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *viewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
@@ -0,0 +1,30 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccViewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                data "tableau_views" "superstore" {
+                    workbook_name = "Superstore"
+                }
+                data "tableau_view" "test" {
+                    workbook_id = data.tableau_views.superstore.views[0].workbook_id
+                    name        = data.tableau_views.superstore.views[0].name
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_view.test", "id"),
+					resource.TestCheckResourceAttrSet("data.tableau_view.test", "content_url"),
+					resource.TestCheckResourceAttrPair("data.tableau_view.test", "id", "data.tableau_views.superstore", "views.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &viewPermissionResource{}
-	_ resource.ResourceWithConfigure   = &viewPermissionResource{}
-	_ resource.ResourceWithImportState = &viewPermissionResource{}
+	_ resource.Resource                     = &viewPermissionResource{}
+	_ resource.ResourceWithConfigure        = &viewPermissionResource{}
+	_ resource.ResourceWithImportState      = &viewPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &viewPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &viewPermissionResource{}
 )
 
 func NewViewPermissionResource() resource.Resource {
@@ -34,6 +36,8 @@ type viewPermissionResourceModel struct {
 	ViewID         types.String `tfsdk:"view_id"`
 	UserID         types.String `tfsdk:"user_id"`
 	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
 }
@@ -61,37 +65,27 @@ func (r *viewPermissionResource) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"user_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "User ID to grant to",
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Group ID to grant to",
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
 			"capability_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The capability to assign permissions to, one of AddComment/ChangePermissions/Delete/ExportData/ExportImage/ExportXml/Filter/Read/ShareView/ViewComments/ViewUnderlyingData/WebAuthoring/Write",
+				Description: capabilityNameDescription("view"),
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"AddComment",
-						"ChangePermissions",
-						"Delete",
-						"ExportData",
-						"ExportImage",
-						"ExportXml",
-						"Filter",
-						"Read",
-						"ShareView",
-						"ViewComments",
-						"ViewUnderlyingData",
-						"WebAuthoring",
-						"Write",
-					}...),
+					capabilityNameValidator("view"),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -114,6 +108,18 @@ func (r *viewPermissionResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *viewPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *viewPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validateContentNotLocked(ctx, client, "view", path.Root("view_id"), req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
 func (r *viewPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan viewPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -122,6 +128,19 @@ func (r *viewPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	viewID := plan.ViewID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -147,7 +166,7 @@ func (r *viewPermissionResource) Create(ctx context.Context, req resource.Create
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateViewPermissions(viewID, viewPermissions)
+	_, err = siteClient.CreateViewPermissions(viewID, viewPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating view permission",
@@ -156,7 +175,7 @@ func (r *viewPermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	plan.ID = types.StringValue(getViewPermissionID(viewID, entityType, entityID, capability.Name, capability.Mode))
+	plan.ID = types.StringValue(getSiteScopedID(getViewPermissionID(viewID, entityType, entityID, capability.Name, capability.Mode), getClientSiteID(r.client, siteClient)))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -173,8 +192,18 @@ func (r *viewPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	permission := getViewPermissionFromID(state.ID.ValueString())
-	viewPermission, err := r.client.GetViewPermission(permission.ViewID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getViewPermissionFromID(permissionID)
+	viewPermission, err := siteClient.GetViewPermission(permission.ViewID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -185,7 +214,10 @@ func (r *viewPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 	} else {
 		state.GroupID = types.StringValue(viewPermission.EntityID)
 	}
-	state.ID = types.StringValue(getViewPermissionID(viewPermission.ViewID, viewPermission.EntityType, viewPermission.EntityID, viewPermission.CapabilityName, viewPermission.CapabilityMode))
+	state.ID = types.StringValue(getSiteScopedID(getViewPermissionID(viewPermission.ViewID, viewPermission.EntityType, viewPermission.EntityID, viewPermission.CapabilityName, viewPermission.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
 	state.ViewID = types.StringValue(viewPermission.ViewID)
 	state.CapabilityName = types.StringValue(viewPermission.CapabilityName)
 	state.CapabilityMode = types.StringValue(viewPermission.CapabilityMode)
@@ -220,9 +252,19 @@ func (r *viewPermissionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	permission := getViewPermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getViewPermissionFromID(permissionID)
 	if permission.EntityType == "users" {
-		err := r.client.DeleteViewPermission(&permission.EntityID, nil, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteViewPermission(&permission.EntityID, nil, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau View",
@@ -231,7 +273,7 @@ func (r *viewPermissionResource) Delete(ctx context.Context, req resource.Delete
 			return
 		}
 	} else {
-		err := r.client.DeleteViewPermission(nil, &permission.EntityID, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteViewPermission(nil, &permission.EntityID, permission.ViewID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau View",
@@ -251,7 +293,7 @@ func (r *viewPermissionResource) Configure(_ context.Context, req resource.Confi
 }
 
 func (r *viewPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importPermissionState(ctx, r.client, req, resp, "view", nil, getViewPermissionID)
 }
 
 func getViewPermissionID(viewID, entityType, entityID, capabilityName, capabilityMode string) string {
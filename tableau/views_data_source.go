@@ -0,0 +1,249 @@
+package tableau
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &viewsDataSource{}
+	_ datasource.DataSourceWithConfigure = &viewsDataSource{}
+)
+
+func ViewsDataSource() datasource.DataSource {
+	return &viewsDataSource{}
+}
+
+type viewsDataSource struct {
+	client *Client
+}
+
+type viewsNestedDataModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ContentURL     types.String `tfsdk:"content_url"`
+	ViewURLName    types.String `tfsdk:"view_url_name"`
+	SheetType      types.String `tfsdk:"sheet_type"`
+	WorkbookID     types.String `tfsdk:"workbook_id"`
+	OwnerID        types.String `tfsdk:"owner_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Tags           types.List   `tfsdk:"tags"`
+	TotalViewCount types.Int64  `tfsdk:"total_view_count"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+type viewsDataSourceModel struct {
+	Site                   types.String           `tfsdk:"site"`
+	ID                     types.String           `tfsdk:"id"`
+	WorkbookID             types.String           `tfsdk:"workbook_id"`
+	Name                   types.String           `tfsdk:"name"`
+	WorkbookName           types.String           `tfsdk:"workbook_name"`
+	ProjectName            types.String           `tfsdk:"project_name"`
+	OwnerName              types.String           `tfsdk:"owner_name"`
+	Tag                    types.String           `tfsdk:"tag"`
+	IncludeUsageStatistics types.Bool             `tfsdk:"include_usage_statistics"`
+	Views                  []viewsNestedDataModel `tfsdk:"views"`
+}
+
+func (d *viewsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_views"
+}
+
+func (d *viewsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve views details for the site or a single workbook",
+		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the views listing",
+			},
+			"workbook_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views of this workbook",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views with this name",
+			},
+			"workbook_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views of workbooks with this name",
+			},
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views in projects with this name",
+			},
+			"owner_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views owned by the user with this name",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return views with this tag",
+			},
+			"include_usage_statistics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Include the total view count of each view",
+			},
+			"views": schema.ListNestedAttribute{
+				Description: "List of views and their attributes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the view",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the view",
+						},
+						"content_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Content URL of the view",
+						},
+						"view_url_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL name of the view within its workbook",
+						},
+						"sheet_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Sheet type, one of dashboard/story/view",
+						},
+						"workbook_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the view workbook",
+						},
+						"owner_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the view owner",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the view project",
+						},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "List of tags on the view",
+						},
+						"total_view_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Total number of times the view was accessed, only set with include_usage_statistics",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "View was created at",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "View was updated at",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *viewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state viewsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	filter := ViewFilter{
+		Name:         state.Name.ValueString(),
+		WorkbookName: state.WorkbookName.ValueString(),
+		ProjectName:  state.ProjectName.ValueString(),
+		OwnerName:    state.OwnerName.ValueString(),
+		Tag:          state.Tag.ValueString(),
+	}
+	workbookID := state.WorkbookID.ValueString()
+	// The views of a workbook are listed directly unless they are also filtered on the workbook, its project or owner
+	listWorkbookViews := workbookID != "" && filter.WorkbookName == "" && filter.ProjectName == "" && filter.OwnerName == ""
+	var views []View
+	if listWorkbookViews {
+		views, err = siteClient.GetWorkbookViews(workbookID, state.IncludeUsageStatistics.ValueBool())
+	} else {
+		views, err = siteClient.GetViews(filter, state.IncludeUsageStatistics.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Views",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, view := range views {
+		if listWorkbookViews && !filter.matchesViewAttributes(view) {
+			continue
+		}
+		if workbookID != "" && view.Workbook.ID != workbookID {
+			continue
+		}
+		state.Views = append(state.Views, getViewNestedDataModel(view))
+	}
+
+	state.ID = types.StringValue("allViews")
+	if workbookID != "" {
+		state.ID = types.StringValue(workbookID)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *viewsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Client)
+}
+
+func getViewNestedDataModel(view View) viewsNestedDataModel {
+	tags := make([]attr.Value, 0, len(view.Tags.Tags))
+	for _, tag := range view.Tags.Tags {
+		tags = append(tags, types.StringValue(tag.Label))
+	}
+	totalViewCount := types.Int64Null()
+	if view.Usage != nil {
+		if count, err := strconv.ParseInt(view.Usage.TotalViewCount, 10, 64); err == nil {
+			totalViewCount = types.Int64Value(count)
+		}
+	}
+	return viewsNestedDataModel{
+		ID:             types.StringValue(view.ID),
+		Name:           types.StringValue(view.Name),
+		ContentURL:     types.StringValue(view.ContentURL),
+		ViewURLName:    types.StringValue(view.ViewURLName),
+		SheetType:      types.StringValue(view.SheetType),
+		WorkbookID:     types.StringValue(view.Workbook.ID),
+		OwnerID:        types.StringValue(view.Owner.ID),
+		ProjectID:      types.StringValue(view.Project.ID),
+		Tags:           types.ListValueMust(types.StringType, tags),
+		TotalViewCount: totalViewCount,
+		CreatedAt:      types.StringValue(view.CreatedAt),
+		UpdatedAt:      types.StringValue(view.UpdatedAt),
+	}
+}
@@ -0,0 +1,44 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccViewsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+                data "tableau_views" "test" {
+                    include_usage_statistics = true
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tableau_views.test", "id"),
+					resource.TestCheckResourceAttrSet("data.tableau_views.test", "views.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestViewFilterMatchesViewAttributes(t *testing.T) {
+	view := View{Name: "Overview", Tags: Tags{Tags: []Tag{{Label: "finance"}}}}
+
+	tests := []struct {
+		filter   ViewFilter
+		expected bool
+	}{
+		{filter: ViewFilter{}, expected: true},
+		{filter: ViewFilter{Name: "Overview", Tag: "finance"}, expected: true},
+		{filter: ViewFilter{Name: "Details"}, expected: false},
+		{filter: ViewFilter{Tag: "sales"}, expected: false},
+	}
+	for _, test := range tests {
+		if actual := test.filter.matchesViewAttributes(view); actual != test.expected {
+			t.Errorf("expected %t for %+v, got %t", test.expected, test.filter, actual)
+		}
+	}
+}
@@ -29,6 +29,7 @@ type virtualConnectionConnectionsNestedDataModel struct {
 	UserName      types.String `tfsdk:"username"`
 }
 type virtualConnectionConnectionsDataSourceModel struct {
+	Site        types.String                                  `tfsdk:"site"`
 	ID          types.String                                  `tfsdk:"id"`
 	Connections []virtualConnectionConnectionsNestedDataModel `tfsdk:"connections"`
 }
@@ -41,6 +42,7 @@ func (d *virtualConnectionConnectionsDataSource) Schema(_ context.Context, _ dat
 	resp.Schema = schema.Schema{
 		Description: "Retrieve virtual connection's connections details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the virtual connections",
@@ -82,7 +84,16 @@ func (d *virtualConnectionConnectionsDataSource) Read(ctx context.Context, req d
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	connections, err := d.client.GetVirtualConnectionConnections(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	connections, err := siteClient.GetVirtualConnectionConnections(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Database Connections of Tableau Virtual Connection",
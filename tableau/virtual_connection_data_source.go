@@ -22,6 +22,7 @@ type virtualConnectionDataSource struct {
 }
 
 type virtualConnectionDataSourceModel struct {
+	Site      types.String `tfsdk:"site"`
 	ID        types.String `tfsdk:"id"`
 	ProjectID types.String `tfsdk:"project_id"`
 	OwnerID   types.String `tfsdk:"owner_id"`
@@ -37,6 +38,7 @@ func (d *virtualConnectionDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schema.Schema{
 		Description: "Retrieve virtual Connection details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the virtual Connection",
@@ -66,7 +68,16 @@ func (d *virtualConnectionDataSource) Read(ctx context.Context, req datasource.R
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	virtualConnection, err := d.client.GetVirtualConnection(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	virtualConnection, err := siteClient.GetVirtualConnection(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Download Tableau Virtual Connection",
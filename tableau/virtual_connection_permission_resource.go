@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &virtualConnectionPermissionResource{}
-	_ resource.ResourceWithConfigure   = &virtualConnectionPermissionResource{}
-	_ resource.ResourceWithImportState = &virtualConnectionPermissionResource{}
+	_ resource.Resource                     = &virtualConnectionPermissionResource{}
+	_ resource.ResourceWithConfigure        = &virtualConnectionPermissionResource{}
+	_ resource.ResourceWithImportState      = &virtualConnectionPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &virtualConnectionPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &virtualConnectionPermissionResource{}
 )
 
 func NewVirtualConnectionPermissionResource() resource.Resource {
@@ -34,6 +36,8 @@ type virtualConnectionPermissionResourceModel struct {
 	VirtualConnectionID types.String `tfsdk:"virtualConnection_id"`
 	UserID              types.String `tfsdk:"user_id"`
 	GroupID             types.String `tfsdk:"group_id"`
+	GranteeName         types.String `tfsdk:"grantee_name"`
+	Site                types.String `tfsdk:"site"`
 	CapabilityName      types.String `tfsdk:"capability_name"`
 	CapabilityMode      types.String `tfsdk:"capability_mode"`
 }
@@ -61,30 +65,27 @@ func (r *virtualConnectionPermissionResource) Schema(_ context.Context, _ resour
 			},
 			"user_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "User ID to grant to",
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Group ID to grant to",
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
 			"capability_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The capability to assign permissions to, one of Read/Connect/Overwrite/ChangeHierarchy/Delete/ChangePermissions",
+				Description: capabilityNameDescription("virtual_connection"),
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"Read",
-						"Connect",
-						"Overwrite",
-						"ChangeHierarchy",
-						"Delete",
-						"ChangePermissions",
-					}...),
+					capabilityNameValidator("virtual_connection"),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -107,6 +108,18 @@ func (r *virtualConnectionPermissionResource) Schema(_ context.Context, _ resour
 	}
 }
 
+func (r *virtualConnectionPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *virtualConnectionPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validateContentNotLocked(ctx, client, "virtual_connection", path.Root("virtual_connection_id"), req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
 func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan virtualConnectionPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -115,6 +128,19 @@ func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req re
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	virtualConnectionID := plan.VirtualConnectionID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -140,7 +166,7 @@ func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req re
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateVirtualConnectionPermissions(virtualConnectionID, virtualConnectionPermissions)
+	_, err = siteClient.CreateVirtualConnectionPermissions(virtualConnectionID, virtualConnectionPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating virtual connection permission",
@@ -149,7 +175,7 @@ func (r *virtualConnectionPermissionResource) Create(ctx context.Context, req re
 		return
 	}
 
-	plan.ID = types.StringValue(getVirtualConnectionPermissionID(virtualConnectionID, entityType, entityID, capability.Name, capability.Mode))
+	plan.ID = types.StringValue(getSiteScopedID(getVirtualConnectionPermissionID(virtualConnectionID, entityType, entityID, capability.Name, capability.Mode), getClientSiteID(r.client, siteClient)))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -166,8 +192,18 @@ func (r *virtualConnectionPermissionResource) Read(ctx context.Context, req reso
 		return
 	}
 
-	permission := getVirtualConnectionPermissionFromID(state.ID.ValueString())
-	virtualConnectionPermission, err := r.client.GetVirtualConnectionPermission(permission.VirtualConnectionID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getVirtualConnectionPermissionFromID(permissionID)
+	virtualConnectionPermission, err := siteClient.GetVirtualConnectionPermission(permission.VirtualConnectionID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -178,7 +214,10 @@ func (r *virtualConnectionPermissionResource) Read(ctx context.Context, req reso
 	} else {
 		state.GroupID = types.StringValue(virtualConnectionPermission.EntityID)
 	}
-	state.ID = types.StringValue(getVirtualConnectionPermissionID(virtualConnectionPermission.VirtualConnectionID, virtualConnectionPermission.EntityType, virtualConnectionPermission.EntityID, virtualConnectionPermission.CapabilityName, virtualConnectionPermission.CapabilityMode))
+	state.ID = types.StringValue(getSiteScopedID(getVirtualConnectionPermissionID(virtualConnectionPermission.VirtualConnectionID, virtualConnectionPermission.EntityType, virtualConnectionPermission.EntityID, virtualConnectionPermission.CapabilityName, virtualConnectionPermission.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
 	state.VirtualConnectionID = types.StringValue(virtualConnectionPermission.VirtualConnectionID)
 	state.CapabilityName = types.StringValue(virtualConnectionPermission.CapabilityName)
 	state.CapabilityMode = types.StringValue(virtualConnectionPermission.CapabilityMode)
@@ -213,9 +252,19 @@ func (r *virtualConnectionPermissionResource) Delete(ctx context.Context, req re
 		return
 	}
 
-	permission := getVirtualConnectionPermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getVirtualConnectionPermissionFromID(permissionID)
 	if permission.EntityType == "users" {
-		err := r.client.DeleteVirtualConnectionPermission(&permission.EntityID, nil, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteVirtualConnectionPermission(&permission.EntityID, nil, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau virtual connection",
@@ -224,7 +273,7 @@ func (r *virtualConnectionPermissionResource) Delete(ctx context.Context, req re
 			return
 		}
 	} else {
-		err := r.client.DeleteVirtualConnectionPermission(nil, &permission.EntityID, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteVirtualConnectionPermission(nil, &permission.EntityID, permission.VirtualConnectionID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau virtual connection",
@@ -244,7 +293,7 @@ func (r *virtualConnectionPermissionResource) Configure(_ context.Context, req r
 }
 
 func (r *virtualConnectionPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importPermissionState(ctx, r.client, req, resp, "virtual_connection", nil, getVirtualConnectionPermissionID)
 }
 
 func getVirtualConnectionPermissionID(virtualConnectionID, entityType, entityID, capabilityName, capabilityMode string) string {
@@ -30,6 +30,7 @@ type virtualConnectionRevisionNestedDataModel struct {
 }
 
 type virtualConnectionRevisionsDataSourceModel struct {
+	Site      types.String                               `tfsdk:"site"`
 	ID        types.String                               `tfsdk:"id"`
 	Revisions []virtualConnectionRevisionNestedDataModel `tfsdk:"revisions"`
 }
@@ -42,6 +43,7 @@ func (d *virtualConnectionRevisionsDataSource) Schema(_ context.Context, _ datas
 	resp.Schema = schema.Schema{
 		Description: "Retrieve virtual connection revisions details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the virtual connections",
@@ -83,7 +85,16 @@ func (d *virtualConnectionRevisionsDataSource) Read(ctx context.Context, req dat
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	revisions, err := d.client.GetVirtualConnectionRevisions(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	revisions, err := siteClient.GetVirtualConnectionRevisions(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Virtual Connection Revisions",
@@ -30,6 +30,7 @@ type virtualConnectionsNestedDataModel struct {
 }
 
 type virtualConnectionsDataSourceModel struct {
+	Site               types.String                        `tfsdk:"site"`
 	ID                 types.String                        `tfsdk:"id"`
 	VirtualConnections []virtualConnectionsNestedDataModel `tfsdk:"virtual_connections"`
 }
@@ -42,6 +43,7 @@ func (d *virtualConnectionsDataSource) Schema(_ context.Context, _ datasource.Sc
 	resp.Schema = schema.Schema{
 		Description: "Retrieve virtual connections details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the virtual connections",
@@ -83,7 +85,16 @@ func (d *virtualConnectionsDataSource) Read(ctx context.Context, req datasource.
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	virtualConnections, err := d.client.GetVirtualConnections()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	virtualConnections, err := siteClient.GetVirtualConnections()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Virtual Connection",
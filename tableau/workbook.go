@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 type Workbook struct {
@@ -29,15 +30,67 @@ type Workbook struct {
 		ID string `json:"id,omitempty"`
 		// Name string `json:"name,omitempty"`
 	} `json:"project,omitempty"`
-	CreatedAt string `json:"createdAt,omitempty"`
-	UpdatedAt string `json:"updatedAt,omitempty"`
+	CreatedAt              string                  `json:"createdAt,omitempty"`
+	UpdatedAt              string                  `json:"updatedAt,omitempty"`
+	DataAccelerationConfig *DataAccelerationConfig `json:"dataAccelerationConfig,omitempty"`
+	DataFreshnessPolicy    *DataFreshnessPolicy    `json:"dataFreshnessPolicy,omitempty"`
 	// Tags
 }
 
+type DataAccelerationConfig struct {
+	AccelerationEnabled *bool `json:"accelerationEnabled,omitempty"`
+}
+
+type FreshEverySchedule struct {
+	Frequency string `json:"frequency,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type FreshAtInterval struct {
+	WeekDay  string `json:"weekDay,omitempty"`
+	MonthDay string `json:"monthDay,omitempty"`
+}
+
+type FreshAtIntervals struct {
+	Intervals []FreshAtInterval `json:"interval,omitempty"`
+}
+
+type FreshAtSchedule struct {
+	Frequency string            `json:"frequency,omitempty"`
+	Time      string            `json:"time,omitempty"`
+	Timezone  string            `json:"timezone,omitempty"`
+	Intervals *FreshAtIntervals `json:"intervals,omitempty"`
+}
+
+type DataFreshnessPolicy struct {
+	Option             string              `json:"option,omitempty"`
+	FreshEverySchedule *FreshEverySchedule `json:"freshEverySchedule,omitempty"`
+	FreshAtSchedule    *FreshAtSchedule    `json:"freshAtSchedule,omitempty"`
+}
+
+// WorkbookUpdate holds the workbook attributes that can be changed without republishing.
+type WorkbookUpdate struct {
+	Description            *string                 `json:"description,omitempty"`
+	ShowTabs               string                  `json:"showTabs,omitempty"`
+	EncryptExtracts        string                  `json:"encryptExtracts,omitempty"`
+	Owner                  *Owner                  `json:"owner,omitempty"`
+	Project                *Owner                  `json:"project,omitempty"`
+	DataAccelerationConfig *DataAccelerationConfig `json:"dataAccelerationConfig,omitempty"`
+	DataFreshnessPolicy    *DataFreshnessPolicy    `json:"dataFreshnessPolicy,omitempty"`
+}
+
 type WorkbookRequest struct {
 	Workbook Workbook `json:"workbook"`
 }
 
+type WorkbookUpdateRequest struct {
+	Workbook WorkbookUpdate `json:"workbook"`
+}
+
+type WorkbookResponse struct {
+	Workbook Workbook `json:"workbook"`
+}
+
 type WorkbooksResponse struct {
 	Workbooks []Workbook `json:"workbook"`
 }
@@ -91,3 +144,52 @@ func (c *Client) GetWorkbooks() ([]Workbook, error) {
 
 	return allWorkbooks, nil
 }
+
+func (c *Client) GetWorkbook(workbookID string) (*Workbook, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workbookResponse.Workbook, nil
+}
+
+func (c *Client) UpdateWorkbook(workbookID string, workbook WorkbookUpdate) (*Workbook, error) {
+	workbookRequest := WorkbookUpdateRequest{
+		Workbook: workbook,
+	}
+
+	newWorkbookJson, err := json.Marshal(workbookRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), strings.NewReader(string(newWorkbookJson)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workbookResponse.Workbook, nil
+}
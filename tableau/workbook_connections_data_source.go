@@ -35,6 +35,7 @@ type workbookConnectionNestedDataModel struct {
 }
 
 type workbookConnectionsDataSourceModel struct {
+	Site        types.String                        `tfsdk:"site"`
 	ID          types.String                        `tfsdk:"id"`
 	Connections []workbookConnectionNestedDataModel `tfsdk:"connections"`
 }
@@ -47,6 +48,7 @@ func (d *workbookConnectionsDataSource) Schema(_ context.Context, _ datasource.S
 	resp.Schema = schema.Schema{
 		Description: "Retrieve workbook connections details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the workbook",
@@ -108,7 +110,16 @@ func (d *workbookConnectionsDataSource) Read(ctx context.Context, req datasource
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	connections, err := d.client.GetWorkbookConnections(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	connections, err := siteClient.GetWorkbookConnections(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbook Connections",
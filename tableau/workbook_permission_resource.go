@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &workbookPermissionResource{}
-	_ resource.ResourceWithConfigure   = &workbookPermissionResource{}
-	_ resource.ResourceWithImportState = &workbookPermissionResource{}
+	_ resource.Resource                     = &workbookPermissionResource{}
+	_ resource.ResourceWithConfigure        = &workbookPermissionResource{}
+	_ resource.ResourceWithImportState      = &workbookPermissionResource{}
+	_ resource.ResourceWithConfigValidators = &workbookPermissionResource{}
+	_ resource.ResourceWithModifyPlan       = &workbookPermissionResource{}
 )
 
 func NewWorkbookPermissionResource() resource.Resource {
@@ -34,6 +36,8 @@ type workbookPermissionResourceModel struct {
 	WorkbookID     types.String `tfsdk:"workbook_id"`
 	UserID         types.String `tfsdk:"user_id"`
 	GroupID        types.String `tfsdk:"group_id"`
+	GranteeName    types.String `tfsdk:"grantee_name"`
+	Site           types.String `tfsdk:"site"`
 	CapabilityName types.String `tfsdk:"capability_name"`
 	CapabilityMode types.String `tfsdk:"capability_mode"`
 }
@@ -44,7 +48,7 @@ func (r *workbookPermissionResource) Metadata(_ context.Context, req resource.Me
 
 func (r *workbookPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Tableau View Permission",
+		Description: "Tableau Workbook Permission",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -61,40 +65,27 @@ func (r *workbookPermissionResource) Schema(_ context.Context, _ resource.Schema
 			},
 			"user_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "User ID to grant to",
+				Computed:    true,
+				Description: "User ID to grant to, conflicts with group_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"group_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "Group ID to grant to",
+				Computed:    true,
+				Description: "Group ID to grant to, conflicts with user_id and grantee_name",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"grantee_name": granteeNameAttribute(),
+			"site":         siteAttribute(),
 			"capability_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The capability to assign permissions to, one of AddComment/ChangeHierarchy/ChangePermissions/CreateRefreshMetrics/Delete/ExportData/ExportImage/ExportXml/Filter/Read/RunExplainData/ShareView/ViewComments/ViewUnderlyingData/WebAuthoring/Write",
+				Description: capabilityNameDescription("workbook"),
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"AddComment",
-						"ChangeHierarchy",
-						"ChangePermissions",
-						"CreateRefreshMetrics",
-						"Delete",
-						"ExportData",
-						"ExportImage",
-						"ExportXml",
-						"Filter",
-						"Read",
-						"RunExplainData",
-						"ShareView",
-						"ViewComments",
-						"ViewUnderlyingData",
-						"WebAuthoring",
-						"Write",
-					}...),
+					capabilityNameValidator("workbook"),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -117,6 +108,18 @@ func (r *workbookPermissionResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *workbookPermissionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return granteeConfigValidators()
+}
+
+func (r *workbookPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+	client := getPlanSiteClient(ctx, r.client, req)
+	resolvePlannedGrantee(ctx, client, req, resp)
+	validateContentNotLocked(ctx, client, "workbook", path.Root("workbook_id"), req, resp)
+	validatePermissionSiteRole(ctx, client, req, resp)
+}
+
 func (r *workbookPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan workbookPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -125,6 +128,19 @@ func (r *workbookPermissionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resolveGrantee(siteClient, plan.GranteeName, &plan.UserID, &plan.GroupID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	workbookID := plan.WorkbookID.ValueString()
 	capability := Capability{
 		Name: plan.CapabilityName.ValueString(),
@@ -150,7 +166,7 @@ func (r *workbookPermissionResource) Create(ctx context.Context, req resource.Cr
 		GranteeCapabilities: []GranteeCapability{granteeCapability},
 	}
 
-	_, err := r.client.CreateWorkbookPermissions(workbookID, workbookPermissions)
+	_, err = siteClient.CreateWorkbookPermissions(workbookID, workbookPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating workbook permission",
@@ -159,7 +175,7 @@ func (r *workbookPermissionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	plan.ID = types.StringValue(getWorkbookPermissionID(workbookID, entityType, entityID, capability.Name, capability.Mode))
+	plan.ID = types.StringValue(getSiteScopedID(getWorkbookPermissionID(workbookID, entityType, entityID, capability.Name, capability.Mode), getClientSiteID(r.client, siteClient)))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -176,8 +192,18 @@ func (r *workbookPermissionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	permission := getWorkbookPermissionFromID(state.ID.ValueString())
-	workbookPermission, err := r.client.GetWorkbookPermission(permission.WorkbookID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getWorkbookPermissionFromID(permissionID)
+	workbookPermission, err := siteClient.GetWorkbookPermission(permission.WorkbookID, permission.EntityID, permission.EntityType, permission.CapabilityName, permission.CapabilityMode)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -188,7 +214,10 @@ func (r *workbookPermissionResource) Read(ctx context.Context, req resource.Read
 	} else {
 		state.GroupID = types.StringValue(workbookPermission.EntityID)
 	}
-	state.ID = types.StringValue(getWorkbookPermissionID(workbookPermission.WorkbookID, workbookPermission.EntityType, workbookPermission.EntityID, workbookPermission.CapabilityName, workbookPermission.CapabilityMode))
+	state.ID = types.StringValue(getSiteScopedID(getWorkbookPermissionID(workbookPermission.WorkbookID, workbookPermission.EntityType, workbookPermission.EntityID, workbookPermission.CapabilityName, workbookPermission.CapabilityMode), siteID))
+	if siteID != "" && state.Site.IsNull() {
+		state.Site = types.StringValue(siteID)
+	}
 	state.WorkbookID = types.StringValue(workbookPermission.WorkbookID)
 	state.CapabilityName = types.StringValue(workbookPermission.CapabilityName)
 	state.CapabilityMode = types.StringValue(workbookPermission.CapabilityMode)
@@ -223,9 +252,19 @@ func (r *workbookPermissionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	permission := getWorkbookPermissionFromID(state.ID.ValueString())
+	permissionID, siteID := splitSiteScopedID(state.ID.ValueString(), 1)
+	siteClient, err := r.client.GetSiteClient(siteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	permission := getWorkbookPermissionFromID(permissionID)
 	if permission.EntityType == "users" {
-		err := r.client.DeleteWorkbookPermission(&permission.EntityID, nil, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteWorkbookPermission(&permission.EntityID, nil, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Workbook",
@@ -234,7 +273,7 @@ func (r *workbookPermissionResource) Delete(ctx context.Context, req resource.De
 			return
 		}
 	} else {
-		err := r.client.DeleteWorkbookPermission(nil, &permission.EntityID, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
+		err := siteClient.DeleteWorkbookPermission(nil, &permission.EntityID, permission.WorkbookID, permission.CapabilityName, permission.CapabilityMode)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Tableau Workbook",
@@ -254,7 +293,7 @@ func (r *workbookPermissionResource) Configure(_ context.Context, req resource.C
 }
 
 func (r *workbookPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importPermissionState(ctx, r.client, req, resp, "workbook", (*Client).ResolveWorkbookImportID, getWorkbookPermissionID)
 }
 
 func getWorkbookPermissionID(workbookID, entityType, entityID, capabilityName, capabilityMode string) string {
@@ -23,6 +23,15 @@ type WorkbookRevisionRequest struct {
 	WorkbookRevision WorkbookRevision `json:"workbookRevisions"`
 }
 
+type WorkbookPublish struct {
+	Name    string `json:"name"`
+	Project Owner  `json:"project"`
+}
+
+type WorkbookPublishRequest struct {
+	Workbook WorkbookPublish `json:"workbook"`
+}
+
 type WorkbookRevisionsResponse struct {
 	WorkbookRevisions []WorkbookRevision `json:"revision"`
 }
@@ -76,3 +85,96 @@ func (c *Client) GetWorkbookRevisions(workbookID string) ([]WorkbookRevision, er
 	}
 	return allWorkbookRevisions, nil
 }
+
+func (c *Client) DownloadWorkbookRevision(workbookID, revisionNumber string) ([]byte, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workbooks/%s/revisions/%s/content", c.ApiUrl, workbookID, revisionNumber), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doContentRequest(req)
+}
+
+// PublishWorkbook publishes workbook content into a project, overwriting any workbook with the same name.
+func (c *Client) PublishWorkbook(name, projectID string, content []byte) (*Workbook, error) {
+	publishRequest := WorkbookPublishRequest{
+		Workbook: WorkbookPublish{
+			Name:    name,
+			Project: Owner{ID: projectID},
+		},
+	}
+
+	publishJson, err := json.Marshal(publishRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := name + ".twb"
+	if IsPackagedContent(content) {
+		fileName += "x"
+	}
+	body, err := c.publishContent("workbook", fileName, publishJson, content)
+	if err != nil {
+		return nil, err
+	}
+
+	workbookResponse := WorkbookResponse{}
+	err = json.Unmarshal(body, &workbookResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workbookResponse.Workbook, nil
+}
+
+func (c *Client) GetCurrentWorkbookRevision(workbookID string) (*WorkbookRevision, error) {
+	revisions, err := c.GetWorkbookRevisions(workbookID)
+	if err != nil {
+		return nil, err
+	}
+	for i, revision := range revisions {
+		if revision.Current {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("did not find current revision of workbook ID %s", workbookID)
+}
+
+// RestoreWorkbookRevision republishes the given revision as the current one and returns the resulting current revision.
+// Nothing is published when the given revision is already current.
+func (c *Client) RestoreWorkbookRevision(workbookID, revisionNumber string) (*WorkbookRevision, error) {
+	revisions, err := c.GetWorkbookRevisions(workbookID)
+	if err != nil {
+		return nil, err
+	}
+	var targetRevision *WorkbookRevision
+	for i, revision := range revisions {
+		if revision.RevisionNumber == revisionNumber {
+			targetRevision = &revisions[i]
+		}
+	}
+	if targetRevision == nil {
+		return nil, fmt.Errorf("did not find revision %s of workbook ID %s", revisionNumber, workbookID)
+	}
+	if targetRevision.Current {
+		return targetRevision, nil
+	}
+	if targetRevision.Deleted {
+		return nil, fmt.Errorf("revision %s of workbook ID %s has been deleted", revisionNumber, workbookID)
+	}
+
+	workbook, err := c.GetWorkbook(workbookID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := c.DownloadWorkbookRevision(workbookID, revisionNumber)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.PublishWorkbook(workbook.Name, workbook.Project.ID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetCurrentWorkbookRevision(workbookID)
+}
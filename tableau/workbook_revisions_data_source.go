@@ -30,6 +30,7 @@ type workbookRevisionNestedDataModel struct {
 }
 
 type workbookRevisionsDataSourceModel struct {
+	Site      types.String                      `tfsdk:"site"`
 	ID        types.String                      `tfsdk:"id"`
 	Revisions []workbookRevisionNestedDataModel `tfsdk:"revisions"`
 }
@@ -42,6 +43,7 @@ func (d *workbookRevisionsDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schema.Schema{
 		Description: "Retrieve workbook revisions details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the workbook",
@@ -83,7 +85,16 @@ func (d *workbookRevisionsDataSource) Read(ctx context.Context, req datasource.R
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	revisions, err := d.client.GetWorkbookRevisions(state.ID.ValueString())
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	revisions, err := siteClient.GetWorkbookRevisions(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbook Revisions",
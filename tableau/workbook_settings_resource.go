@@ -0,0 +1,515 @@
+package tableau
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &workbookSettingsResource{}
+	_ resource.ResourceWithConfigure   = &workbookSettingsResource{}
+	_ resource.ResourceWithImportState = &workbookSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &workbookSettingsResource{}
+)
+
+func NewWorkbookSettingsResource() resource.Resource {
+	return &workbookSettingsResource{}
+}
+
+type workbookSettingsResource struct {
+	client *Client
+}
+
+type dataFreshnessPolicyModel struct {
+	Option              types.String `tfsdk:"option"`
+	FreshEveryFrequency types.String `tfsdk:"fresh_every_frequency"`
+	FreshEveryValue     types.Int64  `tfsdk:"fresh_every_value"`
+	FreshAtFrequency    types.String `tfsdk:"fresh_at_frequency"`
+	FreshAtTime         types.String `tfsdk:"fresh_at_time"`
+	FreshAtTimezone     types.String `tfsdk:"fresh_at_timezone"`
+	FreshAtIntervals    types.List   `tfsdk:"fresh_at_intervals"`
+}
+
+type workbookSettingsResourceModel struct {
+	ID                      types.String              `tfsdk:"id"`
+	Site                    types.String              `tfsdk:"site"`
+	WorkbookID              types.String              `tfsdk:"workbook_id"`
+	Name                    types.String              `tfsdk:"name"`
+	OwnerID                 types.String              `tfsdk:"owner_id"`
+	ProjectID               types.String              `tfsdk:"project_id"`
+	Description             types.String              `tfsdk:"description"`
+	ShowTabs                types.Bool                `tfsdk:"show_tabs"`
+	EncryptExtracts         types.Bool                `tfsdk:"encrypt_extracts"`
+	DataAccelerationEnabled types.Bool                `tfsdk:"data_acceleration_enabled"`
+	DataFreshnessPolicy     *dataFreshnessPolicyModel `tfsdk:"data_freshness_policy"`
+	LastUpdated             types.String              `tfsdk:"last_updated"`
+}
+
+func (r *workbookSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workbook_settings"
+}
+
+func (r *workbookSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of an existing workbook without republishing its content. Destroying the resource leaves the workbook untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": siteAttribute(),
+			"workbook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the existing workbook to manage",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the workbook",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the workbook owner",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier for the project the workbook is placed in",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description for the workbook",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"show_tabs": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not the workbook shows views in tabs",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"encrypt_extracts": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not extracts of the workbook are encrypted",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_acceleration_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable or disable data acceleration for the workbook",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_freshness_policy": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Data freshness policy of the workbook",
+				Attributes: map[string]schema.Attribute{
+					"option": schema.StringAttribute{
+						Required:    true,
+						Description: "Freshness option, one of AlwaysLive/SiteDefault/FreshEvery/FreshAt",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"AlwaysLive",
+								"SiteDefault",
+								"FreshEvery",
+								"FreshAt",
+							}...),
+						},
+					},
+					"fresh_every_frequency": schema.StringAttribute{
+						Optional:    true,
+						Description: "Frequency unit when option is FreshEvery, one of Minutes/Hours/Days/Weeks",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"Minutes",
+								"Hours",
+								"Days",
+								"Weeks",
+							}...),
+						},
+					},
+					"fresh_every_value": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Number of frequency units when option is FreshEvery",
+					},
+					"fresh_at_frequency": schema.StringAttribute{
+						Optional:    true,
+						Description: "Frequency when option is FreshAt, one of Day/Week/Month",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"Day",
+								"Week",
+								"Month",
+							}...),
+						},
+					},
+					"fresh_at_time": schema.StringAttribute{
+						Optional:    true,
+						Description: "Time of day (HH:MM:SS) when option is FreshAt",
+					},
+					"fresh_at_timezone": schema.StringAttribute{
+						Optional:    true,
+						Description: "Timezone of fresh_at_time, for example America/Los_Angeles",
+					},
+					"fresh_at_intervals": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Week days (Week frequency) or month days (Month frequency) when option is FreshAt",
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the last Terraform update of the workbook settings",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workbookSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ignoreEquivalentSiteChange(ctx, r.client, req, resp)
+}
+
+func (r *workbookSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workbookSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	workbookID := plan.WorkbookID.ValueString()
+	_, err = siteClient.GetWorkbook(workbookID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook",
+			"Could not read Tableau workbook ID "+workbookID+": "+err.Error(),
+		)
+		return
+	}
+
+	workbookUpdate, diags := getWorkbookUpdateFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = siteClient.UpdateWorkbook(workbookID, workbookUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workbook settings",
+			"Could not update workbook settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedWorkbook, err := siteClient.GetWorkbook(workbookID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook",
+			"Could not read Tableau workbook ID "+workbookID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(workbookID)
+	setWorkbookSettingsState(&plan, updatedWorkbook)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workbookSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	workbook, err := siteClient.GetWorkbook(state.ID.ValueString())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.WorkbookID = types.StringValue(workbook.ID)
+	setWorkbookSettingsState(&state, workbook)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workbookSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteClient, err := r.client.ResolveSiteClient(plan.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating site client",
+			"Could not create site client: "+err.Error(),
+		)
+		return
+	}
+
+	workbookUpdate, diags := getWorkbookUpdateFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workbookID := plan.WorkbookID.ValueString()
+	_, err = siteClient.UpdateWorkbook(workbookID, workbookUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tableau Workbook",
+			"Could not update workbook settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedWorkbook, err := siteClient.GetWorkbook(workbookID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook",
+			"Could not read Tableau workbook ID "+workbookID+": "+err.Error(),
+		)
+		return
+	}
+
+	setWorkbookSettingsState(&plan, updatedWorkbook)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workbookSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The workbook itself is owned by its publisher, only stop managing its settings.
+}
+
+func (r *workbookSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Client)
+}
+
+func (r *workbookSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSiteContentState(ctx, r.client, req, resp, "workbookID or project path/workbook name", (*Client).ResolveWorkbookImportID, "workbook_id")
+}
+
+func getWorkbookUpdateFromPlan(ctx context.Context, plan workbookSettingsResourceModel) (WorkbookUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	workbookUpdate := WorkbookUpdate{}
+
+	if !plan.Description.IsNull() && !plan.Description.IsUnknown() {
+		description := plan.Description.ValueString()
+		workbookUpdate.Description = &description
+	}
+	if !plan.ShowTabs.IsNull() && !plan.ShowTabs.IsUnknown() {
+		workbookUpdate.ShowTabs = strconv.FormatBool(plan.ShowTabs.ValueBool())
+	}
+	if !plan.EncryptExtracts.IsNull() && !plan.EncryptExtracts.IsUnknown() {
+		workbookUpdate.EncryptExtracts = strconv.FormatBool(plan.EncryptExtracts.ValueBool())
+	}
+	if !plan.OwnerID.IsNull() && !plan.OwnerID.IsUnknown() {
+		workbookUpdate.Owner = &Owner{ID: plan.OwnerID.ValueString()}
+	}
+	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		workbookUpdate.Project = &Owner{ID: plan.ProjectID.ValueString()}
+	}
+	if !plan.DataAccelerationEnabled.IsNull() && !plan.DataAccelerationEnabled.IsUnknown() {
+		accelerationEnabled := plan.DataAccelerationEnabled.ValueBool()
+		workbookUpdate.DataAccelerationConfig = &DataAccelerationConfig{AccelerationEnabled: &accelerationEnabled}
+	}
+
+	if plan.DataFreshnessPolicy != nil {
+		policyModel := plan.DataFreshnessPolicy
+		policy := DataFreshnessPolicy{
+			Option: policyModel.Option.ValueString(),
+		}
+		switch policy.Option {
+		case "FreshEvery":
+			if policyModel.FreshEveryFrequency.IsNull() || policyModel.FreshEveryValue.IsNull() {
+				diags.AddAttributeError(
+					path.Root("data_freshness_policy"),
+					"Incomplete data freshness policy",
+					"fresh_every_frequency and fresh_every_value are required when option is FreshEvery",
+				)
+				return workbookUpdate, diags
+			}
+			policy.FreshEverySchedule = &FreshEverySchedule{
+				Frequency: policyModel.FreshEveryFrequency.ValueString(),
+				Value:     strconv.FormatInt(policyModel.FreshEveryValue.ValueInt64(), 10),
+			}
+		case "FreshAt":
+			if policyModel.FreshAtFrequency.IsNull() || policyModel.FreshAtTime.IsNull() {
+				diags.AddAttributeError(
+					path.Root("data_freshness_policy"),
+					"Incomplete data freshness policy",
+					"fresh_at_frequency and fresh_at_time are required when option is FreshAt",
+				)
+				return workbookUpdate, diags
+			}
+			schedule := &FreshAtSchedule{
+				Frequency: policyModel.FreshAtFrequency.ValueString(),
+				Time:      policyModel.FreshAtTime.ValueString(),
+				Timezone:  policyModel.FreshAtTimezone.ValueString(),
+			}
+			var intervals []string
+			diags.Append(policyModel.FreshAtIntervals.ElementsAs(ctx, &intervals, false)...)
+			if diags.HasError() {
+				return workbookUpdate, diags
+			}
+			if len(intervals) > 0 {
+				schedule.Intervals = &FreshAtIntervals{}
+				for _, interval := range intervals {
+					if schedule.Frequency == "Month" {
+						schedule.Intervals.Intervals = append(schedule.Intervals.Intervals, FreshAtInterval{MonthDay: interval})
+					} else {
+						schedule.Intervals.Intervals = append(schedule.Intervals.Intervals, FreshAtInterval{WeekDay: interval})
+					}
+				}
+			}
+			policy.FreshAtSchedule = schedule
+		}
+		workbookUpdate.DataFreshnessPolicy = &policy
+	}
+
+	return workbookUpdate, diags
+}
+
+func setWorkbookSettingsState(state *workbookSettingsResourceModel, workbook *Workbook) {
+	state.Name = types.StringValue(workbook.Name)
+	state.OwnerID = types.StringValue(workbook.Owner.ID)
+	state.ProjectID = types.StringValue(workbook.Project.ID)
+	state.Description = types.StringValue(workbook.Description)
+	showTabs, _ := strconv.ParseBool(workbook.ShowTabs)
+	state.ShowTabs = types.BoolValue(showTabs)
+	encryptExtracts, _ := strconv.ParseBool(workbook.EncryptExtracts)
+	state.EncryptExtracts = types.BoolValue(encryptExtracts)
+
+	// The API leaves the acceleration config out of workbooks that were never accelerated
+	accelerationEnabled := false
+	if workbook.DataAccelerationConfig != nil && workbook.DataAccelerationConfig.AccelerationEnabled != nil {
+		accelerationEnabled = *workbook.DataAccelerationConfig.AccelerationEnabled
+	}
+	state.DataAccelerationEnabled = types.BoolValue(accelerationEnabled)
+
+	// Freshness is only tracked once it is managed, to avoid drift on unmanaged workbooks
+	if state.DataFreshnessPolicy == nil {
+		return
+	}
+	if workbook.DataFreshnessPolicy == nil {
+		// The policy was removed on the server
+		state.DataFreshnessPolicy = nil
+		return
+	}
+	policy := workbook.DataFreshnessPolicy
+	policyModel := &dataFreshnessPolicyModel{
+		Option:              types.StringValue(policy.Option),
+		FreshEveryFrequency: types.StringNull(),
+		FreshEveryValue:     types.Int64Null(),
+		FreshAtFrequency:    types.StringNull(),
+		FreshAtTime:         types.StringNull(),
+		FreshAtTimezone:     types.StringNull(),
+		FreshAtIntervals:    types.ListNull(types.StringType),
+	}
+	if policy.FreshEverySchedule != nil {
+		policyModel.FreshEveryFrequency = types.StringValue(policy.FreshEverySchedule.Frequency)
+		if value, err := strconv.ParseInt(policy.FreshEverySchedule.Value, 10, 64); err == nil {
+			policyModel.FreshEveryValue = types.Int64Value(value)
+		}
+	}
+	if policy.FreshAtSchedule != nil {
+		policyModel.FreshAtFrequency = types.StringValue(policy.FreshAtSchedule.Frequency)
+		policyModel.FreshAtTime = types.StringValue(policy.FreshAtSchedule.Time)
+		// The server normalises or defaults the timezone, the configured one is kept
+		policyModel.FreshAtTimezone = state.DataFreshnessPolicy.FreshAtTimezone
+		if policy.FreshAtSchedule.Intervals != nil && len(policy.FreshAtSchedule.Intervals.Intervals) > 0 {
+			intervals := []attr.Value{}
+			for _, interval := range policy.FreshAtSchedule.Intervals.Intervals {
+				if interval.MonthDay != "" {
+					intervals = append(intervals, types.StringValue(interval.MonthDay))
+				} else {
+					intervals = append(intervals, types.StringValue(interval.WeekDay))
+				}
+			}
+			policyModel.FreshAtIntervals = types.ListValueMust(types.StringType, intervals)
+		}
+	}
+	state.DataFreshnessPolicy = policyModel
+}
@@ -0,0 +1,49 @@
+package tableau
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetWorkbookSettingsState(t *testing.T) {
+	configuredPolicy := func() *dataFreshnessPolicyModel {
+		return &dataFreshnessPolicyModel{
+			Option:              types.StringValue("FreshAt"),
+			FreshEveryFrequency: types.StringNull(),
+			FreshEveryValue:     types.Int64Null(),
+			FreshAtFrequency:    types.StringValue("Day"),
+			FreshAtTime:         types.StringValue("06:00:00"),
+			FreshAtTimezone:     types.StringValue("Europe/Paris"),
+			FreshAtIntervals:    types.ListNull(types.StringType),
+		}
+	}
+
+	state := workbookSettingsResourceModel{DataFreshnessPolicy: configuredPolicy()}
+	setWorkbookSettingsState(&state, &Workbook{
+		DataFreshnessPolicy: &DataFreshnessPolicy{
+			Option:          "FreshAt",
+			FreshAtSchedule: &FreshAtSchedule{Frequency: "Day", Time: "06:00:00", Timezone: "CET"},
+		},
+	})
+	if state.DataFreshnessPolicy == nil || state.DataFreshnessPolicy.FreshAtTimezone.ValueString() != "Europe/Paris" {
+		t.Errorf("expected the configured timezone to be kept, got %v", state.DataFreshnessPolicy)
+	}
+	if state.DataAccelerationEnabled.IsNull() || state.DataAccelerationEnabled.ValueBool() {
+		t.Errorf("expected data acceleration to be read as disabled, got %s", state.DataAccelerationEnabled)
+	}
+
+	state = workbookSettingsResourceModel{DataFreshnessPolicy: configuredPolicy()}
+	setWorkbookSettingsState(&state, &Workbook{})
+	if state.DataFreshnessPolicy != nil {
+		t.Errorf("expected the policy removed on the server to be removed from state, got %v", state.DataFreshnessPolicy)
+	}
+
+	state = workbookSettingsResourceModel{}
+	setWorkbookSettingsState(&state, &Workbook{
+		DataFreshnessPolicy: &DataFreshnessPolicy{Option: "AlwaysLive"},
+	})
+	if state.DataFreshnessPolicy != nil {
+		t.Errorf("expected the unmanaged policy to stay untracked, got %v", state.DataFreshnessPolicy)
+	}
+}
@@ -40,6 +40,7 @@ type workbooksNestedDataModel struct {
 }
 
 type workbooksDataSourceModel struct {
+	Site      types.String               `tfsdk:"site"`
 	ID        types.String               `tfsdk:"id"`
 	Workbooks []workbooksNestedDataModel `tfsdk:"workbooks"`
 }
@@ -52,6 +53,7 @@ func (d *workbooksDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Retrieve workbooks details",
 		Attributes: map[string]schema.Attribute{
+			"site": siteDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -130,7 +132,16 @@ func (d *workbooksDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	workbooks, err := d.client.GetWorkbooks()
+	siteClient, err := d.client.ResolveSiteClient(state.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Tableau Site",
+			err.Error(),
+		)
+		return
+	}
+
+	workbooks, err := siteClient.GetWorkbooks()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Workbooks",